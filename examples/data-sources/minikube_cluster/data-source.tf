@@ -0,0 +1,11 @@
+data "minikube_cluster" "existing" {
+  cluster_name = "minikube"
+}
+
+provider "kubernetes" {
+  host = data.minikube_cluster.existing.host
+
+  client_certificate     = data.minikube_cluster.existing.client_certificate
+  client_key             = data.minikube_cluster.existing.client_key
+  cluster_ca_certificate = data.minikube_cluster.existing.cluster_ca_certificate
+}
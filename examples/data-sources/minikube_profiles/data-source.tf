@@ -0,0 +1,8 @@
+data "minikube_profiles" "all" {}
+
+check "no_leaked_profiles" {
+  assert {
+    condition     = alltrue([for p in data.minikube_profiles.all.profiles : p.status != "invalid"])
+    error_message = "Found invalid minikube profiles left behind by a failed run"
+  }
+}
@@ -0,0 +1,2 @@
+# Addons are imported using <cluster_name>/<addon>
+terraform import minikube_addon.metallb terraform-provider-minikube/metallb
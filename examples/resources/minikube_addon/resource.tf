@@ -0,0 +1,30 @@
+variable "registry_password" {
+  type      = string
+  sensitive = true
+}
+
+resource "minikube_cluster" "docker" {
+  driver       = "docker"
+  cluster_name = "terraform-provider-minikube-acc-docker"
+}
+
+resource "minikube_addon" "metallb" {
+  cluster_name = minikube_cluster.docker.cluster_name
+  addon        = "metallb"
+
+  metallb {
+    start_ip = "192.168.49.100"
+    end_ip   = "192.168.49.120"
+  }
+}
+
+resource "minikube_addon" "registry_creds" {
+  cluster_name = minikube_cluster.docker.cluster_name
+  addon        = "registry-creds"
+
+  registry_creds {
+    docker_server   = "registry.example.com"
+    docker_username = "ci"
+    docker_password = var.registry_password
+  }
+}
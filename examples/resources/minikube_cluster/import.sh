@@ -0,0 +1,3 @@
+# Clusters are imported using their profile name. Addons enabled on the profile, such as
+# storage-provisioner and default-storageclass, must be listed in the addons of the resource
+terraform import minikube_cluster.docker terraform-provider-minikube-acc-docker
@@ -16,3 +16,12 @@ output "docker_ca" {
   sensitive = true
   value = minikube_cluster.docker.cluster_ca_certificate
 }
+
+output "docker_node_ips" {
+  value = { for n in minikube_cluster.docker.node : coalesce(n.name, "primary") => n.ip }
+}
+
+output "docker_kubeconfig" {
+  sensitive = true
+  value = minikube_cluster.docker.kubeconfig_raw
+}
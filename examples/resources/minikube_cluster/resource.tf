@@ -9,14 +9,22 @@ resource "minikube_cluster" "docker" {
     "default-storageclass",
     "storage-provisioner"
   ]
+
+  lifecycle {
+    postcondition {
+      condition     = self.health == "healthy"
+      error_message = "The cluster was created, but its apiserver is not serving."
+    }
+  }
 }
 
 resource "minikube_cluster" "hyperkit" {
-  vm           = true
-  driver       = "hyperkit"
-  cluster_name = "terraform-provider-minikube-acc-hyperkit"
-  nodes        = 3
-  cni          = "bridge" # Allows pods to communicate with each other via DNS
+  vm              = true
+  driver          = "hyperkit"
+  cluster_name    = "terraform-provider-minikube-acc-hyperkit"
+  nodes           = 3
+  cni             = "bridge" # Allows pods to communicate with each other via DNS
+  kubeconfig_path = "${path.module}/hyperkit.kubeconfig" # Keeps the context out of ~/.kube/config
   addons = [
     "dashboard",
     "default-storageclass",
@@ -0,0 +1,2 @@
+# Nodes are imported using <cluster_name>/<node_name>
+terraform import minikube_node.worker terraform-provider-minikube/m02
@@ -0,0 +1,14 @@
+resource "minikube_cluster" "docker" {
+  driver       = "docker"
+  cluster_name = "terraform-provider-minikube-acc-docker"
+
+  # Nodes attached through minikube_node are not counted towards, nor scaled by, the cluster's nodes
+  nodes = 1
+}
+
+resource "minikube_node" "worker" {
+  count = 2
+
+  cluster_name = minikube_cluster.docker.cluster_name
+  role         = "worker"
+}
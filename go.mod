@@ -3,6 +3,7 @@ module github.com/scott-the-programmer/terraform-provider-minikube
 go 1.25.8
 
 require (
+	github.com/gofrs/flock v0.13.0
 	github.com/golang/mock v1.7.0-rc.1
 	github.com/hashicorp/go-cty v1.5.0
 	github.com/hashicorp/terraform-plugin-docs v0.25.0
@@ -10,6 +11,7 @@ require (
 	github.com/hashicorp/terraform-plugin-sdk/v2 v2.40.1
 	github.com/spf13/viper v1.21.0
 	github.com/stretchr/testify v1.11.1
+	k8s.io/client-go v0.35.1
 	k8s.io/klog/v2 v2.140.0
 	k8s.io/minikube v1.38.0
 )
@@ -127,7 +129,6 @@ require (
 	github.com/go-openapi/swag v0.23.0 // indirect
 	github.com/go-viper/mapstructure/v2 v2.4.0 // indirect
 	github.com/godbus/dbus/v5 v5.1.0 // indirect
-	github.com/gofrs/flock v0.13.0 // indirect
 	github.com/golang/protobuf v1.5.4 // indirect
 	github.com/google/gnostic-models v0.7.0 // indirect
 	github.com/google/go-cmp v0.7.0 // indirect
@@ -285,7 +286,6 @@ require (
 	k8s.io/api v0.35.1 // indirect
 	k8s.io/apimachinery v0.35.1 // indirect
 	k8s.io/cli-runtime v0.35.1 // indirect
-	k8s.io/client-go v0.35.1 // indirect
 	k8s.io/cluster-bootstrap v0.35.1 // indirect
 	k8s.io/component-base v0.35.1 // indirect
 	k8s.io/kube-openapi v0.0.0-20250910181357-589584f1c912 // indirect
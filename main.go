@@ -4,13 +4,20 @@ package main
 
 import (
 	"flag"
+	"os"
 
 	"github.com/scott-the-programmer/terraform-provider-minikube/minikube"
+	"github.com/scott-the-programmer/terraform-provider-minikube/minikube/lib"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
 )
 
 func main() {
+	// In the subprocess execution mode, the provider re-executes itself to run each cluster operation
+	if lib.IsIsolatedHelper() {
+		os.Exit(lib.ServeIsolatedHelper())
+	}
+
 	var debug bool
 
 	flag.BoolVar(&debug, "debug", false, "set to true to run the provider with support for debuggers like delve")
@@ -0,0 +1,127 @@
+package minikube
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceCluster() *schema.Resource {
+	return &schema.Resource{
+		Description: "Used to read an existing minikube cluster on the current host",
+		ReadContext: dataSourceClusterRead,
+		Schema: map[string]*schema.Schema{
+			"cluster_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the minikube cluster",
+			},
+
+			"client_key": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "client key for cluster",
+				Sensitive:   true,
+			},
+
+			"client_certificate": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "client certificate used in cluster",
+				Sensitive:   true,
+			},
+
+			"cluster_ca_certificate": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "certificate authority for cluster",
+				Sensitive:   true,
+			},
+
+			"host": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "the host name for the cluster",
+			},
+
+			"kubeconfig_raw": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "A complete, self-contained kubeconfig for the cluster, with its certificates embedded",
+				Sensitive:   true,
+			},
+
+			"driver": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The driver the cluster runs on",
+			},
+
+			"kubernetes_version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The Kubernetes version the cluster runs",
+			},
+
+			"addons": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Description: "The addons enabled on the cluster",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			// The same node inventory as minikube_cluster, so that the two can be used interchangeably
+			"node": GetClusterSchema()["node"],
+		},
+	}
+}
+
+func dataSourceClusterRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	clusterName := d.Get("cluster_name").(string)
+	client, err := initialiseExistingClusterClient(m, clusterName)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	cc, err := client.LoadClusterConfig()
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	kc, err := client.GetKubeconfig()
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	err = setClusterOutputs(d, kc)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	addons := client.GetAddons()
+	sort.Strings(addons) //to ensure consistency with TF state
+
+	ips, err := client.GetNodeIPs(ctx, cc)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("could not look up the node addresses of cluster %s", clusterName),
+			Detail:   fmt.Sprintf("The addresses are read from the minikube profile only: %v", err),
+		})
+	}
+
+	d.SetId(clusterName)
+	d.Set("driver", cc.Driver)
+	d.Set("kubernetes_version", cc.KubernetesConfig.KubernetesVersion)
+	d.Set("addons", addons)
+	d.Set("node", flattenNodeInventory(cc, cc.Nodes, ips))
+
+	return diags
+}
@@ -0,0 +1,123 @@
+package minikube
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/scott-the-programmer/terraform-provider-minikube/minikube/lib"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/kubeconfig"
+)
+
+func TestDataSourceCluster(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  map[string]*schema.Provider{"minikube": NewProvider(mockExistingCluster(t, "TestDataSourceCluster"))},
+		Steps: []resource.TestStep{
+			{
+				Config: testUnitDataSourceClusterConfig("TestDataSourceCluster"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.minikube_cluster.existing", "id", "TestDataSourceCluster"),
+					resource.TestCheckResourceAttr("data.minikube_cluster.existing", "host", "https://192.168.49.2:8443"),
+					resource.TestCheckResourceAttr("data.minikube_cluster.existing", "client_key", "test contents"),
+					resource.TestCheckResourceAttrSet("data.minikube_cluster.existing", "kubeconfig_raw"),
+					resource.TestCheckResourceAttr("data.minikube_cluster.existing", "driver", "docker"),
+					resource.TestCheckResourceAttr("data.minikube_cluster.existing", "kubernetes_version", "v1.30.0"),
+					resource.TestCheckResourceAttr("data.minikube_cluster.existing", "addons.#", "2"),
+					resource.TestCheckResourceAttr("data.minikube_cluster.existing", "node.#", "2"),
+					resource.TestCheckResourceAttr("data.minikube_cluster.existing", "node.0.role", lib.RoleControlPlane),
+					resource.TestCheckResourceAttr("data.minikube_cluster.existing", "node.0.ip", "192.168.49.2"),
+					resource.TestCheckResourceAttr("data.minikube_cluster.existing", "node.0.name", "TestDataSourceCluster"),
+					resource.TestCheckResourceAttr("data.minikube_cluster.existing", "node.1.name", "TestDataSourceCluster-m02"),
+					resource.TestCheckResourceAttr("data.minikube_cluster.existing", "node.1.role", lib.RoleWorker),
+					resource.TestCheckResourceAttr("data.minikube_cluster.existing", "node.1.ip", "192.168.49.4"),
+				),
+			},
+		},
+	})
+}
+
+func mockExistingCluster(t *testing.T, clusterName string) schema.ConfigureContextFunc {
+	ctrl := gomock.NewController(t)
+	mockClusterClient := lib.NewMockClusterClient(ctrl)
+
+	os.Mkdir("test_output", 0755)
+
+	d1 := []byte("test contents")
+	_ = os.WriteFile("test_output/ca", d1, 0644)
+	_ = os.WriteFile("test_output/certificate", d1, 0644)
+	_ = os.WriteFile("test_output/key", d1, 0644)
+
+	cc := config.ClusterConfig{
+		Name:   clusterName,
+		Driver: "docker",
+		KubernetesConfig: config.KubernetesConfig{
+			KubernetesVersion: "v1.30.0",
+			ContainerRuntime:  "docker",
+		},
+		Nodes: []config.Node{
+			{Name: "", IP: "192.168.49.2", Port: 8443, KubernetesVersion: "v1.30.0", ContainerRuntime: "docker", ControlPlane: true, Worker: true},
+			{Name: "m02", IP: "192.168.49.3", Port: 8443, KubernetesVersion: "v1.30.0", ContainerRuntime: "docker", ControlPlane: false, Worker: true},
+		},
+	}
+
+	mockClusterClient.EXPECT().
+		SetConfig(gomock.Any()).
+		AnyTimes()
+
+	mockClusterClient.EXPECT().
+		SetDependencies(gomock.Any()).
+		AnyTimes()
+
+	mockClusterClient.EXPECT().
+		LoadClusterConfig().
+		Return(&cc, nil).
+		AnyTimes()
+
+	mockClusterClient.EXPECT().
+		GetKubeconfig().
+		Return(&kubeconfig.Settings{
+			ClusterName:          clusterName,
+			ClusterServerAddress: "https://192.168.49.2:8443",
+			ClientCertificate:    "test_output/certificate",
+			CertificateAuthority: "test_output/ca",
+			ClientKey:            "test_output/key",
+		}, nil).
+		AnyTimes()
+
+	// The driver reports a newer address for m02 than the one persisted in the profile
+	mockClusterClient.EXPECT().
+		GetNodeIPs(gomock.Any(), gomock.Any()).
+		Return(map[string]string{"": "192.168.49.2", "m02": "192.168.49.4"}, nil).
+		AnyTimes()
+
+	mockClusterClient.EXPECT().
+		GetAddons().
+		Return([]string{"storage-provisioner", "default-storageclass"}).
+		AnyTimes()
+
+	configureContext := func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+		var diags diag.Diagnostics
+		mockClusterClientFactory := func() (lib.ClusterClient, error) {
+			return mockClusterClient, nil
+		}
+		return mockClusterClientFactory, diags
+	}
+
+	return configureContext
+}
+
+func testUnitDataSourceClusterConfig(clusterName string) string {
+	return fmt.Sprintf(`
+	data "minikube_cluster" "existing" {
+		cluster_name = "%s"
+	}
+	`, clusterName)
+}
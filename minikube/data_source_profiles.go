@@ -0,0 +1,87 @@
+package minikube
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"k8s.io/minikube/pkg/minikube/localpath"
+)
+
+func DataSourceProfiles() *schema.Resource {
+	return &schema.Resource{
+		Description: "Used to list every minikube profile under MINIKUBE_HOME on the current host",
+		ReadContext: dataSourceProfilesRead,
+		Schema: map[string]*schema.Schema{
+			"profiles": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The profiles found on the host, sorted by name",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the profile",
+						},
+						"driver": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The driver the profile runs on",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The state of the profile. One of running, stopped, paused or invalid",
+						},
+						"nodes": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of nodes in the profile",
+						},
+						"kubernetes_version": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The Kubernetes version the profile runs",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceProfilesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	client, err := initialiseExistingClusterClient(m, "")
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	profiles, err := client.ListProfiles()
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	sort.Slice(profiles, func(i, j int) bool {
+		return profiles[i].Name < profiles[j].Name
+	})
+
+	flattened := make([]interface{}, len(profiles))
+	for i, p := range profiles {
+		flattened[i] = map[string]interface{}{
+			"name":               p.Name,
+			"driver":             p.Driver,
+			"status":             p.Status,
+			"nodes":              p.Nodes,
+			"kubernetes_version": p.KubernetesVersion,
+		}
+	}
+
+	d.SetId(localpath.MiniPath())
+	d.Set("profiles", flattened)
+
+	return diags
+}
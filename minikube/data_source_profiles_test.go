@@ -0,0 +1,68 @@
+package minikube
+
+import (
+	"context"
+	"testing"
+
+	"github.com/scott-the-programmer/terraform-provider-minikube/minikube/lib"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestDataSourceProfiles(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  map[string]*schema.Provider{"minikube": NewProvider(mockProfiles(t))},
+		Steps: []resource.TestStep{
+			{
+				Config: `data "minikube_profiles" "all" {}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.minikube_profiles.all", "profiles.#", "3"),
+					resource.TestCheckResourceAttr("data.minikube_profiles.all", "profiles.0.name", "ci-leaked"),
+					resource.TestCheckResourceAttr("data.minikube_profiles.all", "profiles.0.status", lib.StateInvalid),
+					resource.TestCheckResourceAttr("data.minikube_profiles.all", "profiles.1.name", "current"),
+					resource.TestCheckResourceAttr("data.minikube_profiles.all", "profiles.1.status", lib.StateRunning),
+					resource.TestCheckResourceAttr("data.minikube_profiles.all", "profiles.1.nodes", "3"),
+					resource.TestCheckResourceAttr("data.minikube_profiles.all", "profiles.2.name", "next"),
+					resource.TestCheckResourceAttr("data.minikube_profiles.all", "profiles.2.status", lib.StateStopped),
+					resource.TestCheckResourceAttr("data.minikube_profiles.all", "profiles.2.kubernetes_version", "v1.31.0"),
+				),
+			},
+		},
+	})
+}
+
+func mockProfiles(t *testing.T) schema.ConfigureContextFunc {
+	ctrl := gomock.NewController(t)
+	mockClusterClient := lib.NewMockClusterClient(ctrl)
+
+	mockClusterClient.EXPECT().
+		SetConfig(gomock.Any()).
+		AnyTimes()
+
+	mockClusterClient.EXPECT().
+		SetDependencies(gomock.Any()).
+		AnyTimes()
+
+	mockClusterClient.EXPECT().
+		ListProfiles().
+		Return([]lib.Profile{
+			{Name: "next", Driver: "docker", Status: lib.StateStopped, Nodes: 1, KubernetesVersion: "v1.31.0"},
+			{Name: "current", Driver: "docker", Status: lib.StateRunning, Nodes: 3, KubernetesVersion: "v1.30.0"},
+			{Name: "ci-leaked", Status: lib.StateInvalid},
+		}, nil).
+		AnyTimes()
+
+	configureContext := func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+		var diags diag.Diagnostics
+		mockClusterClientFactory := func() (lib.ClusterClient, error) {
+			return mockClusterClient, nil
+		}
+		return mockClusterClientFactory, diags
+	}
+
+	return configureContext
+}
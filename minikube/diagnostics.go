@@ -0,0 +1,29 @@
+package minikube
+
+import (
+	"errors"
+
+	"github.com/scott-the-programmer/terraform-provider-minikube/minikube/lib"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// diagFromErr converts an error into diagnostics, surfacing the reason and advice minikube attached to it
+func diagFromErr(err error) diag.Diagnostics {
+	if err == nil {
+		return nil
+	}
+
+	var minikubeErr *lib.MinikubeError
+	if !errors.As(err, &minikubeErr) {
+		return diag.FromErr(err)
+	}
+
+	return diag.Diagnostics{
+		{
+			Severity: diag.Error,
+			Summary:  err.Error(),
+			Detail:   minikubeErr.Detail(),
+		},
+	}
+}
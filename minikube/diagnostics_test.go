@@ -0,0 +1,38 @@
+package minikube
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/scott-the-programmer/terraform-provider-minikube/minikube/lib"
+
+	"k8s.io/minikube/pkg/minikube/reason"
+)
+
+func TestDiagFromErr(t *testing.T) {
+	if diags := diagFromErr(nil); diags != nil {
+		t.Errorf("diagFromErr() = %v, want nil", diags)
+	}
+
+	diags := diagFromErr(errors.New("plain error"))
+	if len(diags) != 1 || diags[0].Summary != "plain error" || diags[0].Detail != "" {
+		t.Errorf("diagFromErr() = %v, want a single plain error", diags)
+	}
+
+	err := fmt.Errorf("could not start cluster: %w", &lib.MinikubeError{
+		Reason: reason.Kind{ID: "GUEST_START", Advice: "Try again"},
+		Err:    errors.New("boom"),
+	})
+	diags = diagFromErr(err)
+	if len(diags) != 1 {
+		t.Fatalf("diagFromErr() = %v, want a single diagnostic", diags)
+	}
+	if diags[0].Summary != "could not start cluster: boom" {
+		t.Errorf("diagFromErr() summary = %v", diags[0].Summary)
+	}
+	if !strings.Contains(diags[0].Detail, "GUEST_START") || !strings.Contains(diags[0].Detail, "Try again") {
+		t.Errorf("diagFromErr() detail = %v, want the reason and advice", diags[0].Detail)
+	}
+}
@@ -57,6 +57,136 @@ var (
 			Computed:    true,
 			Description: "the host name for the cluster",
 		},
+
+		"kubeconfig_raw": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Sensitive:   true,
+			Description: "A complete, self-contained kubeconfig for the cluster, with its certificates embedded",
+		},
+
+		"kubeconfig_path": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "The kubeconfig file the context of the cluster is written to instead of the global one, with 0600 permissions. Defaults to the provider's kubeconfig_path, then to KUBECONFIG or ~/.kube/config. Changing it restarts the cluster in place",
+		},
+
+		"kubeconfig_previous_context": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The current-context of the kubeconfig before the cluster was created. It is restored on destroy if the context of the cluster is still the current one",
+		},
+
+		"destroy_mode": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Default:          "delete",
+			Description:      "What destroying the resource does to the cluster, one of delete, purge or stop. delete removes the machine and profile, purge also removes the cached ISO, preload and kic base image the cluster was created from, and stop only stops the machine, leaving it on disk to be adopted again by a cluster of the same name",
+			ValidateDiagFunc: state_utils.DestroyModeValidator(),
+		},
+
+		"control_plane_nodes": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "The number of control plane nodes running in the cluster",
+		},
+
+		"node": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "The nodes of the cluster, starting with the primary control plane",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The name of the node's machine, e.g. minikube for the primary control plane of cluster minikube and minikube-m02 for its second node, the same as in status",
+					},
+					"ip": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The IP address of the node, as reported by the driver",
+					},
+					"role": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The role of the node, either control-plane or worker",
+					},
+					"container_runtime": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The container runtime of the node",
+					},
+					"kubernetes_version": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The Kubernetes version of the node",
+					},
+				},
+			},
+		},
+
+		"health": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The overall health of the cluster, one of healthy, degraded, paused or stopped. The cluster is only healthy when every component of every node is running",
+		},
+
+		"status": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "The status of each node, as reported by minikube status",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The name of the node's machine",
+					},
+					"host": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The state of the node's machine",
+					},
+					"kubelet": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The state of the node's kubelet",
+					},
+					"apiserver": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The state of the node's apiserver. Irrelevant for workers",
+					},
+					"kubeconfig": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Whether the kubeconfig points at the node. Irrelevant for workers",
+					},
+					"worker": {
+						Type:        schema.TypeBool,
+						Computed:    true,
+						Description: "Whether the node is a worker only, without a control plane",
+					},
+				},
+			},
+		},
+
+		"state": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Computed:         true,
+			Description:      "The desired state of the cluster, one of running, stopped or paused. Changing it stops, pauses or starts the cluster in place, keeping its volumes and images. nodes and addons cannot be changed while the cluster stays stopped. Defaults to the actual state of the cluster",
+			ValidateDiagFunc: state_utils.StateValidator(),
+		},
+
+		"ensure_running": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Start the cluster again on the next apply if it is found stopped, e.g. after the host rebooted. Has no effect when state is set",
+		},
 `
 
 func TestStringProperty(t *testing.T) {
@@ -360,10 +490,9 @@ func TestOverride(t *testing.T) {
 	assert.Equal(t, header+`
 		"memory": {
 			Type:					schema.TypeString,
-			Description:	"Amount of RAM to allocate to Kubernetes (format: <number>[<unit>], where unit = b, k, m or g). Use \"max\" to use the maximum amount of memory. Use \"no-limit\" to not specify a limit (Docker/Podman only))",
+			Description:	"Amount of RAM to allocate to Kubernetes (format: <number>[<unit>], where unit = b, k, m or g). Use \"max\" to use the maximum amount of memory. Use \"no-limit\" to not specify a limit (Docker/Podman only)). Changing it restarts the cluster in place for the docker, podman, kvm2 and qemu2 drivers, other drivers recreate the cluster",
 
 			Optional:			true,
-			ForceNew:			true,
 
 			Default:	"4g",
 			StateFunc:	state_utils.MemoryConverter(),
@@ -394,7 +523,7 @@ func TestUpdateField(t *testing.T) {
 	assert.Equal(t, header+`
 		"addons": {
 			Type:					schema.TypeSet,
-			Description:	"Enable addons. see `+"`minikube addons list`"+` for a list of valid addon names.",
+			Description:	"Enable addons. see `+"`minikube addons list`"+` for a list of valid addon names. Addons enabled outside of this list, e.g. by minikube_addon, are not tracked by the cluster.",
 
 			Optional:			true,
 
@@ -413,6 +542,38 @@ func GetClusterSchema() map[string]*schema.Schema {
 	`, schema)
 }
 
+func TestOptionalComputedField(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockMinikube := NewMockMinikubeBinary(ctrl)
+	mockMinikube.EXPECT().GetVersion(gomock.Any()).Return("Version 999", nil)
+	mockMinikube.EXPECT().GetStartHelpText(gomock.Any()).Return(`
+--kubernetes-version='':
+	I am a great test description
+
+	`, nil)
+	builder := NewSchemaBuilder("fake.go", mockMinikube)
+	schema, err := builder.Build()
+	assert.NoError(t, err)
+	assert.Equal(t, header+`
+		"kubernetes_version": {
+			Type:					schema.TypeString,
+			Description:	"The Kubernetes version that the minikube VM will use (ex: v1.2.3). Defaults to the provider's kubernetes_version. Raising the version upgrades the cluster in place, downgrades are not supported.",
+
+			Computed:			true,
+
+			Optional:			true,
+
+		},
+
+	}
+)
+
+func GetClusterSchema() map[string]*schema.Schema {
+	return clusterSchema
+}
+	`, schema)
+}
+
 func TestDefaultFuncOverride(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	mockMinikube := NewMockMinikubeBinary(ctrl)
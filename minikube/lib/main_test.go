@@ -0,0 +1,28 @@
+package lib
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestMain(m *testing.M) {
+	// Let the test binary stand in for the provider binary when re-exec'd as an isolated helper
+	if IsIsolatedHelper() {
+		os.Exit(ServeIsolatedHelper())
+	}
+
+	// Keep the locks taken by the tests away from the real MINIKUBE_HOME
+	home, err := os.MkdirTemp("", "minikube-home")
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	os.Setenv("MINIKUBE_HOME", filepath.Join(home, ".minikube"))
+
+	code := m.Run()
+
+	os.RemoveAll(home)
+	os.Exit(code)
+}
@@ -0,0 +1,100 @@
+package lib
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"k8s.io/minikube/pkg/minikube/service"
+)
+
+const (
+	MetalLB       = "metallb"
+	RegistryCreds = "registry-creds"
+
+	registryCredsNamespace   = "kube-system"
+	registryCredsPlaceholder = "changeme"
+)
+
+// AddonOptions holds the addon specific settings accepted by `minikube addons enable` and `minikube addons configure`
+type AddonOptions struct {
+	// Images overrides the images used by the addon, keyed by image name (--images)
+	Images map[string]string
+	// Registries overrides the registries used by the addon, keyed by image name (--registries)
+	Registries map[string]string
+
+	MetalLB       *MetalLBOptions
+	RegistryCreds *RegistryCredsOptions
+}
+
+// MetalLBOptions configures the address pool handed out by the metallb addon
+type MetalLBOptions struct {
+	StartIP string
+	EndIP   string
+}
+
+// RegistryCredsOptions configures the private docker registry used by the registry-creds addon
+type RegistryCredsOptions struct {
+	DockerServer   string
+	DockerUsername string
+	DockerPassword string
+}
+
+// joinAddonOverrides converts image or registry overrides into the Name=value,Name=value format minikube expects
+func joinAddonOverrides(overrides map[string]string) string {
+	pairs := make([]string, 0, len(overrides))
+	for k, v := range overrides {
+		pairs = append(pairs, fmt.Sprintf("%s=%s", k, v))
+	}
+
+	sort.Strings(pairs)
+
+	return strings.Join(pairs, ",")
+}
+
+// createRegistryCredsSecrets creates the secrets read by the registry-creds addon. The addon expects a secret
+// for every supported registry, so the ones not configured are filled with placeholders, as minikube does
+func createRegistryCredsSecrets(name string, options *RegistryCredsOptions) error {
+	secrets := map[string]map[string]string{
+		"ecr": {
+			"AWS_ACCESS_KEY_ID":     registryCredsPlaceholder,
+			"AWS_SECRET_ACCESS_KEY": registryCredsPlaceholder,
+			"AWS_SESSION_TOKEN":     registryCredsPlaceholder,
+			"aws-account":           registryCredsPlaceholder,
+			"aws-region":            registryCredsPlaceholder,
+			"aws-assume-role":       registryCredsPlaceholder,
+		},
+		"gcr": {
+			"application_default_credentials.json": registryCredsPlaceholder,
+			"gcrurl":                               "https://gcr.io",
+		},
+		"dpr": {
+			"DOCKER_PRIVATE_REGISTRY_SERVER":   options.DockerServer,
+			"DOCKER_PRIVATE_REGISTRY_USER":     options.DockerUsername,
+			"DOCKER_PRIVATE_REGISTRY_PASSWORD": options.DockerPassword,
+		},
+		"acr": {
+			"ACR_URL":       registryCredsPlaceholder,
+			"ACR_CLIENT_ID": registryCredsPlaceholder,
+			"ACR_PASSWORD":  registryCredsPlaceholder,
+		},
+	}
+
+	for cloud, data := range secrets {
+		err := service.CreateSecret(
+			name,
+			registryCredsNamespace,
+			fmt.Sprintf("%s-%s", RegistryCreds, cloud),
+			data,
+			map[string]string{
+				"app":                           RegistryCreds,
+				"cloud":                         cloud,
+				"kubernetes.io/minikube-addons": RegistryCreds,
+			})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
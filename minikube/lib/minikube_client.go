@@ -2,16 +2,22 @@
 package lib
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"os"
+	"path/filepath"
+	"sort"
 	"strconv"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/spf13/viper"
 	"k8s.io/klog/v2"
 	cmdcfg "k8s.io/minikube/cmd/minikube/cmd/config"
 	"k8s.io/minikube/pkg/libmachine/ssh"
+	"k8s.io/minikube/pkg/minikube/cluster"
 	"k8s.io/minikube/pkg/minikube/config"
 	"k8s.io/minikube/pkg/minikube/kubeconfig"
 	"k8s.io/minikube/pkg/minikube/localpath"
@@ -25,19 +31,47 @@ import (
 const (
 	Podman = "podman"
 	Docker = "docker"
+	KVM2   = "kvm2"
+	QEMU2  = "qemu2"
 
 	MinExtraHANodes = 2
+
+	DestroyModeDelete = "delete"
+	DestroyModePurge  = "purge"
+	DestroyModeStop   = "stop"
 )
 
+// ErrClusterNotFound is returned when the profile of a cluster no longer exists, e.g. after `minikube delete`
+var ErrClusterNotFound = errors.New("cluster not found")
+
 type ClusterClient interface {
 	SetConfig(args MinikubeClientConfig)
 	GetConfig() MinikubeClientConfig
 	SetDependencies(dep MinikubeClientDeps)
-	Start() (*kubeconfig.Settings, error)
-	Delete() error
+	Start(ctx context.Context) (*kubeconfig.Settings, error)
+	Restart(ctx context.Context) (*kubeconfig.Settings, error)
+	ScaleNodes(ctx context.Context, nodes int) error
+	Resize(ctx context.Context) error
+	AddNode(ctx context.Context, options NodeOptions) (*config.Node, error)
+	RemoveNode(ctx context.Context, name string) error
+	AttachNode(ctx context.Context, name string) error
+	GetAttachedNodes() ([]string, error)
+	Stop(ctx context.Context) error
+	Pause(ctx context.Context) error
+	Unpause(ctx context.Context) error
+	Delete(ctx context.Context) error
+	Purge(ctx context.Context) error
 	GetClusterConfig() *config.ClusterConfig
+	LoadClusterConfig() (*config.ClusterConfig, error)
+	GetStatus(ctx context.Context, cc *config.ClusterConfig) ([]*cluster.Status, error)
+	GetNodeIPs(ctx context.Context, cc *config.ClusterConfig) (map[string]string, error)
+	GetKubeconfig() (*kubeconfig.Settings, error)
+	GetKubeconfigPath() string
+	ListProfiles() ([]Profile, error)
 	GetK8sVersion() string
-	ApplyAddons(addons []string) error
+	ApplyAddons(ctx context.Context, addons []string) error
+	EnableAddon(ctx context.Context, addon string, options AddonOptions) error
+	DisableAddon(ctx context.Context, addon string) error
 	GetAddons() []string
 }
 
@@ -50,12 +84,29 @@ type MinikubeClient struct {
 	nodes           int
 	ha              bool
 	nativeSsh       bool
+	kubeconfigPath  string
+	previousContext string
 
-	// TfCreationLock is a mutex used to prevent multiple minikube clients from conflicting on Start().
-	// Only set this if you're using MinikubeClient in a concurrent context
-	TfCreationLock *sync.Mutex
+	// TfCreationLock is shared by the minikube clients of a provider. Viper, the KUBECONFIG environment variable and
+	// the rest of minikube's globals belong to the whole process, so every ClusterClient method holds it:
+	//   - exclusively to change a cluster: Start, Restart, ScaleNodes, Resize, AddNode, RemoveNode, AttachNode, Stop,
+	//     Pause, Unpause, Delete, Purge, ApplyAddons, EnableAddon and DisableAddon
+	//   - shared to read one: GetClusterConfig, LoadClusterConfig, GetAttachedNodes, GetStatus, GetNodeIPs,
+	//     GetKubeconfig, GetKubeconfigPath, GetAddons and ListProfiles, so refreshes of different clusters run in parallel
+	//   - not at all for SetConfig, GetConfig, SetDependencies and GetK8sVersion, which only touch the client itself
+	// A client is used by one goroutine at a time. Each method takes the lock once, before the file locks of the
+	// profile and download cache. Only set this if you're using MinikubeClient in a concurrent context
+	TfCreationLock *sync.RWMutex
 	K8sVersion     string
 
+	// DefaultKubeconfigPath is the kubeconfig written to by clusters that do not set their own path.
+	// Falls back to the file named by KUBECONFIG, or ~/.kube/config
+	DefaultKubeconfigPath string
+
+	// LockTimeout bounds how long operations wait for another terraform run holding the lock of the profile or
+	// the download cache. Zero fails straight away
+	LockTimeout time.Duration
+
 	nRunner Cluster
 	dLoader Downloader
 }
@@ -69,6 +120,8 @@ type MinikubeClientConfig struct {
 	Nodes           int
 	HA              bool
 	NativeSsh       bool
+	KubeconfigPath  string
+	PreviousContext string
 }
 
 type MinikubeClientDeps struct {
@@ -88,24 +141,62 @@ func NewMinikubeClient(args MinikubeClientConfig, dep MinikubeClientDeps) *Minik
 		nodes:           args.Nodes,
 		nativeSsh:       args.NativeSsh,
 		ha:              args.HA,
+		kubeconfigPath:  args.KubeconfigPath,
+		previousContext: args.PreviousContext,
 
 		nRunner: dep.Node,
 		dLoader: dep.Downloader,
 	}
 }
 
+// minikubeBinDir is the bin directory of MINIKUBE_HOME that was put on PATH
+var minikubeBinDir string
+
 func init() {
 	registerLogging()
 	klog.V(klog.Level(1))
 
-	targetDir := localpath.MakeMiniPath("bin")
-	new := fmt.Sprintf("%s:%s", targetDir, os.Getenv("PATH"))
-	os.Setenv("PATH", new)
+	addMinikubeBinToPath()
 
 	register.Reg.SetStep(register.InitialSetup)
 
 }
 
+// UseMinikubeHome points clusters, their profiles and the download cache at another MINIKUBE_HOME.
+// minikube reads MINIKUBE_HOME from the environment, so the home applies to the whole process, helpers included
+func UseMinikubeHome(home string) error {
+	if home == "" {
+		return nil
+	}
+
+	home, err := filepath.Abs(home)
+	if err != nil {
+		return err
+	}
+
+	err = os.Setenv("MINIKUBE_HOME", home)
+	if err != nil {
+		return err
+	}
+
+	addMinikubeBinToPath()
+
+	return nil
+}
+
+// addMinikubeBinToPath puts the driver binaries of MINIKUBE_HOME first on PATH, in place of those of the previous home
+func addMinikubeBinToPath() {
+	paths := []string{localpath.MakeMiniPath("bin")}
+	for _, path := range filepath.SplitList(os.Getenv("PATH")) {
+		if minikubeBinDir == "" || path != minikubeBinDir {
+			paths = append(paths, path)
+		}
+	}
+
+	minikubeBinDir = paths[0]
+	os.Setenv("PATH", strings.Join(paths, string(os.PathListSeparator)))
+}
+
 // SetConfig sets the clients configuration
 func (e *MinikubeClient) SetConfig(args MinikubeClientConfig) {
 	e.clusterConfig = args.ClusterConfig
@@ -116,6 +207,8 @@ func (e *MinikubeClient) SetConfig(args MinikubeClientConfig) {
 	e.nodes = args.Nodes
 	e.nativeSsh = args.NativeSsh
 	e.ha = args.HA
+	e.kubeconfigPath = args.KubeconfigPath
+	e.previousContext = args.PreviousContext
 }
 
 // GetConfig retrieves the current clients configuration
@@ -128,6 +221,9 @@ func (e *MinikubeClient) GetConfig() MinikubeClientConfig {
 		DeleteOnFailure: e.deleteOnFailure,
 		Nodes:           e.nodes,
 		HA:              e.ha,
+		NativeSsh:       e.nativeSsh,
+		KubeconfigPath:  e.kubeconfigPath,
+		PreviousContext: e.previousContext,
 	}
 }
 
@@ -137,38 +233,42 @@ func (e *MinikubeClient) SetDependencies(dep MinikubeClientDeps) {
 	e.dLoader = dep.Downloader
 }
 
-// Start starts the minikube creation process. If the cluster already exists, it will attempt to reuse it
-func (e *MinikubeClient) Start() (*kubeconfig.Settings, error) {
+// Start starts the minikube creation process. If the cluster already exists, it will attempt to reuse it.
+// Cancelling ctx stops the creation at the next step, as a single minikube step cannot be interrupted. What was
+// created up to then is deleted if delete on failure is set
+func (e *MinikubeClient) Start(ctx context.Context) (*kubeconfig.Settings, error) {
 
 	// By nature, viper references (here and within the internals of minikube) are not thread safe.
 	// To keep our sanity, let's mutex this call and defer subsequent cluster starts
-	if e.TfCreationLock != nil {
-		e.TfCreationLock.Lock()
-		defer e.TfCreationLock.Unlock()
-	}
+	defer e.lock()()
 
-	viper.Set(cmdcfg.Bootstrapper, "kubeadm")
-	viper.Set(config.ProfileName, e.clusterName)
-	viper.Set("preload", true)
-	viper.Set("ha", e.ha)
-
-	url, err := e.downloadIsos()
+	unlock, err := e.lockProfile(ctx, "start")
 	if err != nil {
 		return nil, err
 	}
+	defer unlock()
 
-	e.clusterConfig.MinikubeISO = url
-	if e.clusterConfig.Driver == Podman || e.clusterConfig.Driver == Docker { // use volume mounts for container runtimes
-		e.clusterConfig.ContainerVolumeMounts = []string{e.clusterConfig.MountString}
+	kc, err := e.start(ctx)
+	if err != nil && ctx.Err() != nil {
+		return nil, e.cleanupInterrupted(err)
 	}
 
-	if e.nativeSsh {
-		ssh.SetDefaultClient(ssh.Native)
-	} else {
-		ssh.SetDefaultClient(ssh.External)
+	return kc, err
+}
+
+func (e *MinikubeClient) start(ctx context.Context) (*kubeconfig.Settings, error) {
+	err := e.prepareStart(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	restoreKubeconfig, err := useKubeconfigPath(e.explicitKubeconfigPath())
+	if err != nil {
+		return nil, err
 	}
+	defer restoreKubeconfig()
 
-	mRunner, preExists, mAPI, host, err := e.nRunner.Provision(e.clusterConfig, &e.clusterConfig.Nodes[0], true)
+	mRunner, preExists, mAPI, host, err := e.nRunner.Provision(ctx, e.clusterConfig, &e.clusterConfig.Nodes[0], true)
 	if err != nil {
 		return nil, err
 	}
@@ -183,29 +283,429 @@ func (e *MinikubeClient) Start() (*kubeconfig.Settings, error) {
 		ExistingAddons: e.clusterConfig.Addons,
 	}
 
-	kc, err := e.nRunner.Start(starter)
+	kc, err := e.nRunner.Start(ctx, starter)
 	if err != nil {
 		return nil, err
 	}
 
-	e.clusterConfig, err = e.addHANodes(e.clusterConfig)
+	e.clusterConfig, err = e.addHANodes(ctx, e.clusterConfig)
 	if err != nil {
 		return nil, err
 	}
 
-	err = e.provisionNodes(starter)
+	err = e.provisionNodes(ctx, starter)
 	if err != nil {
 		return nil, err
 	}
 
 	klog.Flush()
 
-	e.setAddons(e.addons, true)
+	e.setAddons(ctx, e.addons, true)
+
+	return kc, nil
+}
+
+// cleanupInterrupted deletes what an interrupted Start created when delete on failure is set, and keeps it otherwise
+func (e *MinikubeClient) cleanupInterrupted(err error) error {
+	if len(e.nRunner.Leftovers(e.clusterConfig, e.clusterName)) == 0 {
+		return fmt.Errorf("creating cluster %s was interrupted: %w", e.clusterName, err)
+	}
+
+	if !e.deleteOnFailure {
+		return fmt.Errorf("creating cluster %s was interrupted, the partially created cluster was kept: %w", e.clusterName, err)
+	}
+
+	// ctx is already done, so the cleanup has to run without it
+	_, delErr := e.nRunner.Delete(context.Background(), e.clusterConfig, e.clusterName)
+	if delErr != nil {
+		return fmt.Errorf("creating cluster %s was interrupted, and the partially created cluster could not be deleted (%v): %w", e.clusterName, delErr, err)
+	}
+
+	return fmt.Errorf("creating cluster %s was interrupted, the partially created cluster was deleted: %w", e.clusterName, err)
+}
+
+// Restart re-runs the start process against an existing cluster, applying the settings that can change in place
+// (e.g. a newer Kubernetes version) to each of its nodes. Other settings of the existing profile are kept
+func (e *MinikubeClient) Restart(ctx context.Context) (*kubeconfig.Settings, error) {
+
+	// By nature, viper references (here and within the internals of minikube) are not thread safe.
+	// To keep our sanity, let's mutex this call and defer subsequent cluster starts
+	defer e.lock()()
+
+	unlock, err := e.lockProfile(ctx, "restart")
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	existing := e.nRunner.Get(e.clusterName)
+	if existing == nil || len(existing.Nodes) == 0 {
+		return nil, fmt.Errorf("%w: %s", ErrClusterNotFound, e.clusterName)
+	}
+
+	err = e.prepareStart(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	restoreKubeconfig, err := useKubeconfigPath(e.explicitKubeconfigPath())
+	if err != nil {
+		return nil, err
+	}
+	defer restoreKubeconfig()
+
+	// Start persists the config it is given, so apply the changes to the existing profile rather than replacing it,
+	// which would drop the settings of other resources such as minikube_addon
+	e.clusterConfig = restartConfig(existing, e.clusterConfig)
+
+	mRunner, preExists, mAPI, host, err := e.nRunner.Provision(ctx, e.clusterConfig, &e.clusterConfig.Nodes[0], false)
+	if err != nil {
+		return nil, err
+	}
+	starter := node.Starter{
+		Runner:         mRunner,
+		PreExists:      preExists,
+		StopK8s:        false,
+		MachineAPI:     mAPI,
+		Host:           host,
+		Cfg:            e.clusterConfig,
+		Node:           &e.clusterConfig.Nodes[0],
+		ExistingAddons: existing.Addons,
+	}
+
+	kc, err := e.nRunner.Start(ctx, starter)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, n := range e.clusterConfig.Nodes[1:] {
+		err = e.nRunner.StartNode(ctx, e.clusterConfig, n)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	klog.Flush()
 
 	return kc, nil
 }
 
-func (e *MinikubeClient) addHANodes(cc *config.ClusterConfig) (*config.ClusterConfig, error) {
+// restartConfig overlays the settings Restart applies onto a copy of the existing profile. The existing node pool is
+// kept, but every node is brought onto the desired Kubernetes version
+func restartConfig(existing *config.ClusterConfig, desired *config.ClusterConfig) *config.ClusterConfig {
+	cc := *existing
+
+	cc.CPUs = desired.CPUs
+	cc.Memory = desired.Memory
+	cc.RegistryMirror = desired.RegistryMirror
+	cc.KubernetesConfig.KubernetesVersion = desired.KubernetesConfig.KubernetesVersion
+	cc.KubernetesConfig.ExtraOptions = desired.KubernetesConfig.ExtraOptions
+	cc.KubernetesConfig.FeatureGates = desired.KubernetesConfig.FeatureGates
+	cc.KubernetesConfig.APIServerNames = desired.KubernetesConfig.APIServerNames
+	cc.KubernetesConfig.APIServerIPs = desired.KubernetesConfig.APIServerIPs
+
+	cc.Nodes = make([]config.Node, len(existing.Nodes))
+	for i, n := range existing.Nodes {
+		n.KubernetesVersion = desired.KubernetesConfig.KubernetesVersion
+		cc.Nodes[i] = n
+	}
+
+	return &cc
+}
+
+// ScaleNodes resizes the node pool of an existing cluster in place. The nodes are counted the same way as they are
+// reported: machines removed by hand are left out, and so are nodes attached through AddNode, which are never touched.
+// Scaling up first recreates the machines removed by hand, then adds workers, scaling down drains and deletes the
+// highest numbered workers. Control plane nodes are never removed
+func (e *MinikubeClient) ScaleNodes(ctx context.Context, nodes int) error {
+	defer e.lock()()
+
+	unlock, err := e.lockProfile(ctx, "scale")
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	viper.Set(config.ProfileName, e.clusterName)
+
+	cc := e.nRunner.Get(e.clusterName)
+	if cc == nil {
+		return fmt.Errorf("%w: %s", ErrClusterNotFound, e.clusterName)
+	}
+
+	attached, err := AttachedNodes(e.clusterName)
+	if err != nil {
+		return err
+	}
+
+	statuses, err := e.nRunner.Status(ctx, cc)
+	if err != nil {
+		klog.Warningf("unable to retrieve the machines of cluster %s, counting every node of its profile: %v", e.clusterName, err)
+	}
+
+	owned := OwnedNodes(cc.Nodes, attached)
+	existing := OwnedNodes(ExistingNodes(cc, statuses), attached)
+
+	current := len(existing)
+	if nodes > current {
+		removed := make(map[string]bool, len(owned))
+		for _, n := range owned {
+			removed[n.Name] = true
+		}
+		for _, n := range existing {
+			delete(removed, n.Name)
+		}
+
+		for _, n := range owned {
+			if current == nodes {
+				break
+			}
+			if !removed[n.Name] {
+				continue
+			}
+
+			err := e.nRunner.StartNode(ctx, cc, n)
+			if err != nil {
+				return err
+			}
+			current++
+		}
+
+		cc.MultiNodeRequested = true
+		for ; current < nodes; current++ {
+			err := e.nRunner.AddWorkerNode(ctx, cc,
+				cc.KubernetesConfig.KubernetesVersion,
+				cc.APIServerPort,
+				cc.KubernetesConfig.ContainerRuntime)
+			if err != nil {
+				return err
+			}
+		}
+	} else if nodes < current {
+		workers := make([]string, 0)
+		for _, n := range existing {
+			if !n.ControlPlane {
+				workers = append(workers, n.Name)
+			}
+		}
+
+		remove := current - nodes
+		if remove > len(workers) {
+			return fmt.Errorf("cannot scale cluster %s down to %d nodes, only %d of its %d nodes are workers", e.clusterName, nodes, len(workers), current)
+		}
+
+		sort.Slice(workers, func(i, j int) bool {
+			return nodeIndex(workers[i]) > nodeIndex(workers[j])
+		})
+
+		for _, name := range workers[:remove] {
+			// Reload the config every time, as each deletion persists a new node pool
+			cc := e.nRunner.Get(e.clusterName)
+			if cc == nil {
+				return fmt.Errorf("%w: %s", ErrClusterNotFound, e.clusterName)
+			}
+
+			err := e.nRunner.DeleteNode(ctx, cc, name)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	e.nodes = nodes
+
+	return nil
+}
+
+// AddNode attaches a single worker or control plane node to an existing cluster, returning the node once provisioned
+func (e *MinikubeClient) AddNode(ctx context.Context, options NodeOptions) (*config.Node, error) {
+	defer e.lock()()
+
+	unlock, err := e.lockProfile(ctx, "node add")
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	viper.Set(config.ProfileName, e.clusterName)
+
+	cc := e.nRunner.Get(e.clusterName)
+	if cc == nil {
+		return nil, fmt.Errorf("%w: %s", ErrClusterNotFound, e.clusterName)
+	}
+
+	kv := options.KubernetesVersion
+	if kv == "" {
+		kv = cc.KubernetesConfig.KubernetesVersion
+	}
+
+	cr := options.ContainerRuntime
+	if cr == "" {
+		cr = cc.KubernetesConfig.ContainerRuntime
+	}
+
+	name := nextNodeName(cc)
+	cc.MultiNodeRequested = true
+
+	switch options.Role {
+	case RoleControlPlane:
+		if !config.IsHA(*cc) {
+			return nil, fmt.Errorf("control plane nodes can only be added to a highly available cluster, %s is not one", e.clusterName)
+		}
+		_, err = e.nRunner.AddControlPlaneNode(ctx, cc, kv, cc.APIServerPort, cr)
+	case RoleWorker, "":
+		err = e.nRunner.AddWorkerNode(ctx, cc, kv, cc.APIServerPort, cr)
+	default:
+		return nil, fmt.Errorf("unknown node role %s", options.Role)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Keep the node out of the node count of the cluster, which would otherwise scale it away
+	err = SetNodeAttached(e.clusterName, name, true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Reload the config, as provisioning persists the node along with its IP
+	cc = e.nRunner.Get(e.clusterName)
+	if cc == nil {
+		return nil, fmt.Errorf("%w: %s", ErrClusterNotFound, e.clusterName)
+	}
+
+	return findNode(cc, name)
+}
+
+// RemoveNode drains and deletes a single node of an existing cluster. The primary control plane cannot be removed
+func (e *MinikubeClient) RemoveNode(ctx context.Context, name string) error {
+	defer e.lock()()
+
+	if name == "" {
+		return fmt.Errorf("the primary control plane of cluster %s cannot be removed", e.clusterName)
+	}
+
+	unlock, err := e.lockProfile(ctx, "node removal")
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	viper.Set(config.ProfileName, e.clusterName)
+
+	cc := e.nRunner.Get(e.clusterName)
+	if cc == nil {
+		return fmt.Errorf("%w: %s", ErrClusterNotFound, e.clusterName)
+	}
+
+	_, err = findNode(cc, name)
+	if err != nil {
+		return err
+	}
+
+	err = e.nRunner.DeleteNode(ctx, cc, name)
+	if err != nil {
+		return err
+	}
+
+	return SetNodeAttached(e.clusterName, name, false)
+}
+
+// AttachNode hands an existing node of the cluster over from its node count to a minikube_node resource,
+// e.g. when the node is imported
+func (e *MinikubeClient) AttachNode(ctx context.Context, name string) error {
+	return e.withExistingCluster(ctx, "node import", func(ctx context.Context, cc *config.ClusterConfig) error {
+		_, err := findNode(cc, name)
+		if err != nil {
+			return err
+		}
+
+		return SetNodeAttached(e.clusterName, name, true)
+	})
+}
+
+// GetAttachedNodes returns the names of the nodes attached to the cluster, see AttachedNodes
+func (e *MinikubeClient) GetAttachedNodes() ([]string, error) {
+	defer e.rLock()()
+
+	return AttachedNodes(e.clusterName)
+}
+
+// Resize stops the cluster and applies the configured cpus and memory to each of its machines.
+// The cluster is left stopped, Restart brings it back with the new resources
+func (e *MinikubeClient) Resize(ctx context.Context) error {
+	return e.withExistingCluster(ctx, "resize", func(ctx context.Context, cc *config.ClusterConfig) error {
+		err := e.nRunner.Stop(ctx, cc)
+		if err != nil {
+			return err
+		}
+
+		return e.nRunner.Resize(ctx, cc, e.clusterConfig.CPUs, e.clusterConfig.Memory)
+	})
+}
+
+// Stop stops every node of the cluster, keeping its volumes and images for the next start
+func (e *MinikubeClient) Stop(ctx context.Context) error {
+	return e.withExistingCluster(ctx, "stop", e.nRunner.Stop)
+}
+
+// Pause pauses the kubernetes containers on every node of the cluster
+func (e *MinikubeClient) Pause(ctx context.Context) error {
+	return e.withExistingCluster(ctx, "pause", e.nRunner.Pause)
+}
+
+// Unpause resumes the kubernetes containers on every node of the cluster
+func (e *MinikubeClient) Unpause(ctx context.Context) error {
+	return e.withExistingCluster(ctx, "unpause", e.nRunner.Unpause)
+}
+
+// withExistingCluster runs an operation against the persisted config of the cluster, holding the lock of its profile
+func (e *MinikubeClient) withExistingCluster(ctx context.Context, operation string, op func(ctx context.Context, cc *config.ClusterConfig) error) error {
+	defer e.lock()()
+
+	unlock, err := e.lockProfile(ctx, operation)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	viper.Set(config.ProfileName, e.clusterName)
+
+	cc := e.nRunner.Get(e.clusterName)
+	if cc == nil {
+		return fmt.Errorf("%w: %s", ErrClusterNotFound, e.clusterName)
+	}
+
+	return op(ctx, cc)
+}
+
+// prepareStart configures minikube and retrieves the prerequisites shared by Start and Restart
+func (e *MinikubeClient) prepareStart(ctx context.Context) error {
+	viper.Set(cmdcfg.Bootstrapper, "kubeadm")
+	viper.Set(config.ProfileName, e.clusterName)
+	viper.Set("preload", true)
+	viper.Set("ha", e.ha)
+
+	url, err := e.downloadIsos(ctx)
+	if err != nil {
+		return err
+	}
+
+	e.clusterConfig.MinikubeISO = url
+	if e.clusterConfig.Driver == Podman || e.clusterConfig.Driver == Docker { // use volume mounts for container runtimes
+		e.clusterConfig.ContainerVolumeMounts = []string{e.clusterConfig.MountString}
+	}
+
+	if e.nativeSsh {
+		ssh.SetDefaultClient(ssh.Native)
+	} else {
+		ssh.SetDefaultClient(ssh.External)
+	}
+
+	return nil
+}
+
+func (e *MinikubeClient) addHANodes(ctx context.Context, cc *config.ClusterConfig) (*config.ClusterConfig, error) {
 	if e.ha && e.nodes-1 < MinExtraHANodes { // excluding the initial node
 		return nil, errors.New("you need at least 3 nodes for high availability")
 	}
@@ -213,7 +713,7 @@ func (e *MinikubeClient) addHANodes(cc *config.ClusterConfig) (*config.ClusterCo
 	var err error
 	if e.ha {
 		for i := 0; i < MinExtraHANodes; i++ {
-			cc, err = e.nRunner.AddControlPlaneNode(cc,
+			cc, err = e.nRunner.AddControlPlaneNode(ctx, cc,
 				cc.KubernetesConfig.KubernetesVersion,
 				cc.APIServerPort,
 				cc.KubernetesConfig.ContainerRuntime)
@@ -229,10 +729,10 @@ func (e *MinikubeClient) addHANodes(cc *config.ClusterConfig) (*config.ClusterCo
 
 }
 
-func (e *MinikubeClient) provisionNodes(starter node.Starter) error {
+func (e *MinikubeClient) provisionNodes(ctx context.Context, starter node.Starter) error {
 	// Remaining nodes
 	for i := 0; i < e.nodes-1; i++ { // excluding the initial node
-		err := e.nRunner.AddWorkerNode(e.clusterConfig,
+		err := e.nRunner.AddWorkerNode(ctx, e.clusterConfig,
 			starter.Cfg.KubernetesConfig.KubernetesVersion,
 			starter.Cfg.APIServerPort,
 			starter.Cfg.KubernetesConfig.ContainerRuntime)
@@ -244,25 +744,28 @@ func (e *MinikubeClient) provisionNodes(starter node.Starter) error {
 	return nil
 }
 
-func (e *MinikubeClient) ApplyAddons(addons []string) error {
+func (e *MinikubeClient) ApplyAddons(ctx context.Context, addons []string) error {
 
 	// By nature, viper references (here and within the internals of minikube) are not thread safe.
 	// To keep our sanity, let's mutex this call and defer subsequent cluster starts
-	if e.TfCreationLock != nil {
-		e.TfCreationLock.Lock()
-		defer e.TfCreationLock.Unlock()
+	defer e.lock()()
+
+	unlock, err := e.lockProfile(ctx, "addons")
+	if err != nil {
+		return err
 	}
+	defer unlock()
 
 	viper.Set(config.ProfileName, e.clusterName)
 
 	addonsToDelete := diff(e.addons, addons)
-	err := e.setAddons(addonsToDelete, false)
+	err = e.setAddons(ctx, addonsToDelete, false)
 	if err != nil {
 		return err
 	}
 
 	addonsToAdd := diff(addons, e.addons)
-	err = e.setAddons(addonsToAdd, true)
+	err = e.setAddons(ctx, addonsToAdd, true)
 	if err != nil {
 		return err
 	}
@@ -272,9 +775,40 @@ func (e *MinikubeClient) ApplyAddons(addons []string) error {
 	return nil
 }
 
+// EnableAddon enables a single addon with its addon specific options, independently of the addons set on the client
+func (e *MinikubeClient) EnableAddon(ctx context.Context, addon string, options AddonOptions) error {
+
+	// By nature, viper references (here and within the internals of minikube) are not thread safe.
+	// To keep our sanity, let's mutex this call and defer subsequent cluster starts
+	defer e.lock()()
+
+	unlock, err := e.lockProfile(ctx, "addon enable")
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	viper.Set(config.ProfileName, e.clusterName)
+
+	return e.nRunner.EnableAddon(ctx, e.clusterName, addon, options)
+}
+
+// DisableAddon disables a single addon, independently of the addons set on the client. It returns ErrClusterNotFound
+// if the cluster was deleted outside of terraform
+func (e *MinikubeClient) DisableAddon(ctx context.Context, addon string) error {
+	return e.withExistingCluster(ctx, "addon disable", func(ctx context.Context, cc *config.ClusterConfig) error {
+		return e.setAddons(ctx, []string{addon}, false)
+	})
+}
+
 func (e *MinikubeClient) GetAddons() []string {
 	addons := make([]string, 0)
-	for addon, enabled := range e.GetClusterConfig().Addons {
+	cc := e.GetClusterConfig()
+	if cc == nil {
+		return addons
+	}
+
+	for addon, enabled := range cc.Addons {
 		if enabled {
 			addons = append(addons, addon)
 		}
@@ -297,9 +831,9 @@ func diff(addonsA, addonsB []string) []string {
 	return diff
 }
 
-func (e *MinikubeClient) setAddons(addons []string, val bool) error {
+func (e *MinikubeClient) setAddons(ctx context.Context, addons []string, val bool) error {
 	for _, addon := range addons {
-		err := e.nRunner.SetAddon(e.clusterName, addon, strconv.FormatBool(val))
+		err := e.nRunner.SetAddon(ctx, e.clusterName, addon, strconv.FormatBool(val))
 		if err != nil {
 			return err
 		}
@@ -308,32 +842,213 @@ func (e *MinikubeClient) setAddons(addons []string, val bool) error {
 	return nil
 }
 
-// Delete deletes the given cluster associated with the cluster config
-func (e *MinikubeClient) Delete() error {
-	_, err := e.nRunner.Delete(e.clusterConfig, e.clusterName)
+// Delete deletes the given cluster associated with the cluster config, along with the context minikube wrote for it.
+// It only succeeds once none of the machine and profile directories of the cluster remain
+func (e *MinikubeClient) Delete(ctx context.Context) error {
+	defer e.lock()()
+
+	return e.deleteCluster(ctx)
+}
+
+func (e *MinikubeClient) deleteCluster(ctx context.Context) error {
+	unlock, err := e.lockProfile(ctx, "delete")
 	if err != nil {
 		return err
 	}
-	return nil
+	defer unlock()
+
+	path := e.resolveKubeconfigPath()
+
+	// minikube clears the current-context of the kubeconfig it removes the cluster from, so look it up beforehand
+	current, err := CurrentContext(path)
+	if err != nil {
+		klog.Warningf("could not read the current context of %s: %v", path, err)
+	}
+
+	// The profile knows about every node that was added, whereas the terraform config only describes the first
+	cc := e.nRunner.Get(e.clusterName)
+	if cc == nil {
+		cc = e.clusterConfig
+	}
+
+	// Point minikube at the kubeconfig of the cluster, so that a context of the same name elsewhere is left alone
+	restoreKubeconfig, err := useKubeconfigPath(e.explicitKubeconfigPath())
+	if err != nil {
+		return err
+	}
+	_, err = e.nRunner.Delete(ctx, cc, e.clusterName)
+	restoreKubeconfig()
+
+	// The cluster only counts as deleted once nothing of it remains, as anything left behind may still back a running machine
+	leftovers := e.nRunner.Leftovers(cc, e.clusterName)
+	if len(leftovers) > 0 {
+		if err == nil {
+			err = fmt.Errorf("%s still exist", strings.Join(leftovers, ", "))
+		}
+		return err
+	}
+	if err != nil {
+		klog.Warningf("deleting %s reported %v, but none of its machines or profile remain", e.clusterName, err)
+	}
+
+	previous := ""
+	if current == e.clusterName {
+		previous = e.previousContext
+	}
+
+	return RemoveContext(path, e.clusterName, previous)
+}
+
+// Purge deletes the cluster, then clears the cached ISO, preload and kic base image it was created from
+func (e *MinikubeClient) Purge(ctx context.Context) error {
+	defer e.lock()()
+
+	// The profile records what the cluster was actually created from, but is gone once deleted
+	cc := e.nRunner.Get(e.clusterName)
+	if cc == nil {
+		cc = e.clusterConfig
+	}
+
+	err := e.deleteCluster(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Other clusters may be downloading to the cache, so wait for them to finish
+	cache, err := acquireLock(ctx, cacheLockPath(), true, e.LockTimeout, fmt.Sprintf("purge of cluster %s", e.clusterName))
+	if err != nil {
+		return err
+	}
+	defer cache.Unlock()
+
+	return e.dLoader.Purge(ctx, cc)
+}
+
+// lockProfile takes the lock of the profile exclusively, and the lock of the download cache shared, so that other
+// terraform runs neither change the profile nor purge the cache in the meantime, see fileLock. The returned func
+// releases both
+func (e *MinikubeClient) lockProfile(ctx context.Context, operation string) (func(), error) {
+	operation = fmt.Sprintf("%s of cluster %s", operation, e.clusterName)
+
+	profile, err := acquireLock(ctx, profileLockPath(e.clusterName), true, e.LockTimeout, operation)
+	if err != nil {
+		return nil, err
+	}
+
+	cache, err := acquireLock(ctx, cacheLockPath(), false, e.LockTimeout, operation)
+	if err != nil {
+		profile.Unlock()
+		return nil, err
+	}
+
+	return func() {
+		cache.Unlock()
+		profile.Unlock()
+	}, nil
+}
+
+// lock takes TfCreationLock exclusively for an operation that changes a cluster, returning the func that releases it
+func (e *MinikubeClient) lock() func() {
+	if e.TfCreationLock == nil {
+		return func() {}
+	}
+
+	e.TfCreationLock.Lock()
+	return e.TfCreationLock.Unlock
+}
+
+// rLock takes TfCreationLock shared for an operation that only reads a cluster, returning the func that releases it
+func (e *MinikubeClient) rLock() func() {
+	if e.TfCreationLock == nil {
+		return func() {}
+	}
+
+	e.TfCreationLock.RLock()
+	return e.TfCreationLock.RUnlock
 }
 
 // GetClusterConfig retrieves the latest cluster config from minikube
 func (e *MinikubeClient) GetClusterConfig() *config.ClusterConfig {
+	defer e.rLock()()
+
 	return e.nRunner.Get(e.clusterName)
 }
 
+// LoadClusterConfig retrieves the latest cluster config from minikube, returning ErrClusterNotFound
+// if the cluster was deleted outside of terraform
+func (e *MinikubeClient) LoadClusterConfig() (*config.ClusterConfig, error) {
+	defer e.rLock()()
+
+	return e.nRunner.Load(e.clusterName)
+}
+
+// GetStatus retrieves the machine and kubernetes state of every node of the cluster
+func (e *MinikubeClient) GetStatus(ctx context.Context, cc *config.ClusterConfig) ([]*cluster.Status, error) {
+	defer e.rLock()()
+
+	return e.nRunner.Status(ctx, cc)
+}
+
+// GetNodeIPs looks up the IP address of each node from its driver, keyed by node name
+func (e *MinikubeClient) GetNodeIPs(ctx context.Context, cc *config.ClusterConfig) (map[string]string, error) {
+	defer e.rLock()()
+
+	return e.nRunner.NodeIPs(ctx, cc)
+}
+
+// GetKubeconfig retrieves the connection details of the existing cluster
+func (e *MinikubeClient) GetKubeconfig() (*kubeconfig.Settings, error) {
+	defer e.rLock()()
+
+	return e.nRunner.Kubeconfig(e.clusterName, e.resolveKubeconfigPath())
+}
+
+// GetKubeconfigPath resolves the kubeconfig the cluster's context is written to
+func (e *MinikubeClient) GetKubeconfigPath() string {
+	// Start points KUBECONFIG at the kubeconfig of the cluster being created while it runs
+	defer e.rLock()()
+
+	return e.resolveKubeconfigPath()
+}
+
+// resolveKubeconfigPath is GetKubeconfigPath for callers already holding TfCreationLock
+func (e *MinikubeClient) resolveKubeconfigPath() string {
+	path := e.explicitKubeconfigPath()
+	if path == "" {
+		return kubeconfig.PathFromEnv()
+	}
+
+	return path
+}
+
+// explicitKubeconfigPath is the kubeconfig configured on the cluster or provider, if any
+func (e *MinikubeClient) explicitKubeconfigPath() string {
+	if e.kubeconfigPath != "" {
+		return e.kubeconfigPath
+	}
+
+	return e.DefaultKubeconfigPath
+}
+
+// ListProfiles retrieves a summary of every minikube profile on the host
+func (e *MinikubeClient) ListProfiles() ([]Profile, error) {
+	defer e.rLock()()
+
+	return e.nRunner.ListProfiles()
+}
+
 func (e *MinikubeClient) GetK8sVersion() string {
 	return e.K8sVersion
 }
 
 // downloadIsos retrieve all prerequisite images prior to provisioning
-func (e *MinikubeClient) downloadIsos() (string, error) {
-	url, err := e.dLoader.ISO(e.isoUrls, true)
+func (e *MinikubeClient) downloadIsos(ctx context.Context) (string, error) {
+	url, err := e.dLoader.ISO(ctx, e.isoUrls, true)
 	if err != nil {
 		return "", err
 	}
 
-	err = e.dLoader.PreloadTarball(e.clusterConfig.KubernetesConfig.KubernetesVersion,
+	err = e.dLoader.PreloadTarball(ctx, e.clusterConfig.KubernetesConfig.KubernetesVersion,
 		e.clusterConfig.KubernetesConfig.ContainerRuntime,
 		e.clusterConfig.Driver)
 	if err != nil {
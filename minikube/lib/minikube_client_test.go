@@ -1,14 +1,27 @@
 package lib
 
 import (
+	"context"
 	"errors"
+	"os"
+	"path/filepath"
 	"reflect"
 	"sort"
+	"strings"
 	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	gomock "github.com/golang/mock/gomock"
+	"github.com/spf13/viper"
+	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/minikube/pkg/libmachine"
+	"k8s.io/minikube/pkg/libmachine/host"
+	"k8s.io/minikube/pkg/minikube/cluster"
+	"k8s.io/minikube/pkg/minikube/command"
 	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/localpath"
 	_ "k8s.io/minikube/pkg/minikube/registry/drvs"
 )
 
@@ -23,7 +36,7 @@ func TestMinikubeClient_Start(t *testing.T) {
 		dLoader         Downloader
 		nodes           int
 		ha              bool
-		tfCreationLock  sync.Mutex
+		tfCreationLock  sync.RWMutex
 	}
 
 	ctrl := gomock.NewController(t)
@@ -47,7 +60,7 @@ func TestMinikubeClient_Start(t *testing.T) {
 				nRunner:         getNodeSuccess(ctrl),
 				dLoader:         getDownloadSuccess(ctrl),
 				nodes:           1,
-				tfCreationLock:  sync.Mutex{},
+				tfCreationLock:  sync.RWMutex{},
 			},
 			wantErr: false,
 		},
@@ -67,7 +80,7 @@ func TestMinikubeClient_Start(t *testing.T) {
 				nRunner:         getNodeSuccess(ctrl),
 				dLoader:         getDownloadSuccess(ctrl),
 				nodes:           1,
-				tfCreationLock:  sync.Mutex{},
+				tfCreationLock:  sync.RWMutex{},
 			},
 			wantErr: false,
 		},
@@ -87,7 +100,7 @@ func TestMinikubeClient_Start(t *testing.T) {
 				nRunner:         getMultipleNodesSuccess(ctrl, 3),
 				dLoader:         getDownloadSuccess(ctrl),
 				nodes:           3,
-				tfCreationLock:  sync.Mutex{},
+				tfCreationLock:  sync.RWMutex{},
 			},
 			wantErr: false,
 		},
@@ -107,7 +120,7 @@ func TestMinikubeClient_Start(t *testing.T) {
 				nRunner:         getMultipleNodesFailure(ctrl),
 				dLoader:         getDownloadSuccess(ctrl),
 				nodes:           3,
-				tfCreationLock:  sync.Mutex{},
+				tfCreationLock:  sync.RWMutex{},
 			},
 			wantErr: true,
 		},
@@ -132,7 +145,7 @@ func TestMinikubeClient_Start(t *testing.T) {
 				dLoader:        getDownloadSuccess(ctrl),
 				nodes:          4,
 				ha:             true,
-				tfCreationLock: sync.Mutex{},
+				tfCreationLock: sync.RWMutex{},
 			},
 			wantErr: false,
 		},
@@ -158,7 +171,7 @@ func TestMinikubeClient_Start(t *testing.T) {
 				dLoader:        getDownloadSuccess(ctrl),
 				nodes:          2,
 				ha:             true,
-				tfCreationLock: sync.Mutex{},
+				tfCreationLock: sync.RWMutex{},
 			},
 			wantErr: true,
 		},
@@ -176,7 +189,7 @@ func TestMinikubeClient_Start(t *testing.T) {
 				nRunner:         nil,
 				dLoader:         getDownloadFailure(ctrl),
 				nodes:           1,
-				tfCreationLock:  sync.Mutex{},
+				tfCreationLock:  sync.RWMutex{},
 			},
 			wantErr: true,
 		},
@@ -194,7 +207,7 @@ func TestMinikubeClient_Start(t *testing.T) {
 				nRunner:         nil,
 				dLoader:         getTarballFailure(ctrl),
 				nodes:           1,
-				tfCreationLock:  sync.Mutex{},
+				tfCreationLock:  sync.RWMutex{},
 			},
 			wantErr: true,
 		},
@@ -212,7 +225,7 @@ func TestMinikubeClient_Start(t *testing.T) {
 				nRunner:         getProvisionerFailure(ctrl),
 				dLoader:         getDownloadSuccess(ctrl),
 				nodes:           1,
-				tfCreationLock:  sync.Mutex{},
+				tfCreationLock:  sync.RWMutex{},
 			},
 			wantErr: true,
 		},
@@ -230,7 +243,7 @@ func TestMinikubeClient_Start(t *testing.T) {
 				nRunner:         getStartFailure(ctrl),
 				dLoader:         getDownloadSuccess(ctrl),
 				nodes:           1,
-				tfCreationLock:  sync.Mutex{},
+				tfCreationLock:  sync.RWMutex{},
 			},
 			wantErr: true,
 		},
@@ -248,13 +261,652 @@ func TestMinikubeClient_Start(t *testing.T) {
 				nodes:           tt.fields.nodes,
 				ha:              tt.fields.ha,
 			}
-			if _, err := e.Start(); (err != nil) != tt.wantErr {
+			if _, err := e.Start(context.Background()); (err != nil) != tt.wantErr {
 				t.Errorf("MinikubeClient.Start() error = %v, wantErr %v", err, tt.wantErr)
 			}
 		})
 	}
 }
 
+func TestMinikubeClient_StartInterrupted(t *testing.T) {
+	tests := []struct {
+		name            string
+		deleteOnFailure bool
+		leftovers       []string
+		wantDelete      bool
+	}{
+		{
+			name:            "Deletes The Partial Cluster",
+			deleteOnFailure: true,
+			leftovers:       []string{"/minikube/profiles/cluster"},
+			wantDelete:      true,
+		},
+		{
+			name:            "Keeps The Partial Cluster",
+			deleteOnFailure: false,
+			leftovers:       []string{"/minikube/profiles/cluster"},
+			wantDelete:      false,
+		},
+		{
+			name:            "Nothing Created",
+			deleteOnFailure: true,
+			leftovers:       nil,
+			wantDelete:      false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			nRunner := NewMockCluster(ctrl)
+			nRunner.EXPECT().
+				Provision(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, cc *config.ClusterConfig, n *config.Node, delOnFail bool) (command.Runner, bool, libmachine.API, *host.Host, error) {
+					cancel()
+					return nil, false, nil, nil, ctx.Err()
+				})
+			nRunner.EXPECT().
+				Leftovers(gomock.Any(), "cluster").
+				Return(tt.leftovers)
+			if tt.wantDelete {
+				nRunner.EXPECT().
+					Delete(gomock.Any(), gomock.Any(), "cluster").
+					Return(nil, nil)
+			}
+
+			e := &MinikubeClient{
+				clusterConfig: &config.ClusterConfig{
+					Nodes: []config.Node{
+						{},
+					},
+				},
+				clusterName:     "cluster",
+				deleteOnFailure: tt.deleteOnFailure,
+				nRunner:         nRunner,
+				dLoader:         getDownloadSuccess(ctrl),
+				nodes:           1,
+			}
+
+			_, err := e.Start(ctx)
+			if !errors.Is(err, context.Canceled) {
+				t.Errorf("MinikubeClient.Start() error = %v, want %v", err, context.Canceled)
+			}
+		})
+	}
+}
+
+func TestMinikubeClient_Restart(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	tests := []struct {
+		name     string
+		existing *config.ClusterConfig
+		nRunner  func(existing *config.ClusterConfig) Cluster
+		dLoader  Downloader
+		wantErr  bool
+	}{
+		{
+			name: "Restarts Every Node",
+			existing: &config.ClusterConfig{
+				CPUs:                2,
+				LoadBalancerStartIP: "192.168.49.100",
+				Addons:              map[string]bool{"ingress": true},
+				Nodes: []config.Node{
+					{Name: "", ControlPlane: true, KubernetesVersion: "v1.26.3"},
+					{Name: "m02", Worker: true, KubernetesVersion: "v1.26.3"},
+					{Name: "m03", Worker: true, KubernetesVersion: "v1.26.3"},
+				},
+			},
+			nRunner: func(existing *config.ClusterConfig) Cluster {
+				return getRestartSuccess(ctrl, existing)
+			},
+			dLoader: getDownloadSuccess(ctrl),
+			wantErr: false,
+		},
+		{
+			name:     "Missing Cluster",
+			existing: nil,
+			nRunner: func(existing *config.ClusterConfig) Cluster {
+				nRunner := NewMockCluster(ctrl)
+				nRunner.EXPECT().
+					Get(gomock.Any()).
+					Return(nil)
+				return nRunner
+			},
+			dLoader: NewMockDownloader(ctrl),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := &MinikubeClient{
+				clusterConfig: &config.ClusterConfig{
+					CPUs: 4,
+					KubernetesConfig: config.KubernetesConfig{
+						KubernetesVersion: "v1.27.0",
+					},
+					Nodes: []config.Node{
+						{},
+					},
+				},
+				clusterName:    "cluster",
+				TfCreationLock: &sync.RWMutex{},
+				nRunner:        tt.nRunner(tt.existing),
+				dLoader:        tt.dLoader,
+			}
+			_, err := e.Restart(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MinikubeClient.Restart() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.existing == nil && !errors.Is(err, ErrClusterNotFound) {
+				t.Errorf("MinikubeClient.Restart() error = %v, want ErrClusterNotFound", err)
+			}
+			if err != nil {
+				return
+			}
+
+			if len(e.clusterConfig.Nodes) != len(tt.existing.Nodes) {
+				t.Errorf("nodes = %d, want %d", len(e.clusterConfig.Nodes), len(tt.existing.Nodes))
+			}
+			for _, n := range e.clusterConfig.Nodes {
+				if n.KubernetesVersion != "v1.27.0" {
+					t.Errorf("node %q version = %s, want v1.27.0", n.Name, n.KubernetesVersion)
+				}
+			}
+			if e.clusterConfig.CPUs != 4 {
+				t.Errorf("cpus = %d, want the configured 4", e.clusterConfig.CPUs)
+			}
+			if e.clusterConfig.LoadBalancerStartIP != tt.existing.LoadBalancerStartIP || !e.clusterConfig.Addons["ingress"] {
+				t.Errorf("config = %+v, want the settings of the existing profile to be kept", e.clusterConfig)
+			}
+		})
+	}
+}
+
+func TestMinikubeClient_ScaleNodes(t *testing.T) {
+	threeNodes := func() *config.ClusterConfig {
+		return &config.ClusterConfig{
+			Name: "cluster",
+			Nodes: []config.Node{
+				{Name: "", ControlPlane: true, Worker: true},
+				{Name: "m02", Worker: true},
+				{Name: "m03", Worker: true},
+			},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		nodes    int
+		attached []string
+		nRunner  func(ctrl *gomock.Controller) Cluster
+		wantErr  bool
+	}{
+		{
+			name:  "Adds Workers",
+			nodes: 5,
+			nRunner: func(ctrl *gomock.Controller) Cluster {
+				nRunner := NewMockCluster(ctrl)
+				nRunner.EXPECT().
+					Get("cluster").
+					Return(threeNodes())
+				nRunner.EXPECT().
+					Status(gomock.Any(), gomock.Any()).
+					Return(nil, nil)
+				nRunner.EXPECT().
+					AddWorkerNode(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil).
+					Times(2)
+				return nRunner
+			},
+			wantErr: false,
+		},
+		{
+			name:  "Recreates Machines Removed By Hand",
+			nodes: 3,
+			nRunner: func(ctrl *gomock.Controller) Cluster {
+				nRunner := NewMockCluster(ctrl)
+				nRunner.EXPECT().
+					Get("cluster").
+					Return(threeNodes())
+				nRunner.EXPECT().
+					Status(gomock.Any(), gomock.Any()).
+					Return([]*cluster.Status{
+						{Name: "cluster", Host: "Running"},
+						{Name: "cluster-m02", Host: "Running"},
+						{Name: "cluster-m03", Host: cluster.Nonexistent},
+					}, nil)
+				nRunner.EXPECT().
+					StartNode(gomock.Any(), gomock.Any(), config.Node{Name: "m03", Worker: true}).
+					Return(nil)
+				return nRunner
+			},
+			wantErr: false,
+		},
+		{
+			name:  "Removes Highest Numbered Workers First",
+			nodes: 1,
+			nRunner: func(ctrl *gomock.Controller) Cluster {
+				nRunner := NewMockCluster(ctrl)
+				nRunner.EXPECT().
+					Get("cluster").
+					Return(threeNodes()).
+					AnyTimes()
+				nRunner.EXPECT().
+					Status(gomock.Any(), gomock.Any()).
+					Return(nil, nil)
+				gomock.InOrder(
+					nRunner.EXPECT().
+						DeleteNode(gomock.Any(), gomock.Any(), "m03").
+						Return(nil),
+					nRunner.EXPECT().
+						DeleteNode(gomock.Any(), gomock.Any(), "m02").
+						Return(nil),
+				)
+				return nRunner
+			},
+			wantErr: false,
+		},
+		{
+			name:     "Leaves Attached Nodes Alone",
+			nodes:    1,
+			attached: []string{"m03"},
+			nRunner: func(ctrl *gomock.Controller) Cluster {
+				nRunner := NewMockCluster(ctrl)
+				nRunner.EXPECT().
+					Get("cluster").
+					Return(threeNodes()).
+					AnyTimes()
+				nRunner.EXPECT().
+					Status(gomock.Any(), gomock.Any()).
+					Return(nil, nil)
+				nRunner.EXPECT().
+					DeleteNode(gomock.Any(), gomock.Any(), "m02").
+					Return(nil)
+				return nRunner
+			},
+			wantErr: false,
+		},
+		{
+			name:  "Cluster Deleted While Scaling Down",
+			nodes: 1,
+			nRunner: func(ctrl *gomock.Controller) Cluster {
+				nRunner := NewMockCluster(ctrl)
+				nRunner.EXPECT().
+					Status(gomock.Any(), gomock.Any()).
+					Return(nil, nil)
+				gomock.InOrder(
+					nRunner.EXPECT().
+						Get("cluster").
+						Return(threeNodes()).
+						Times(2),
+					nRunner.EXPECT().
+						DeleteNode(gomock.Any(), gomock.Any(), "m03").
+						Return(nil),
+					nRunner.EXPECT().
+						Get("cluster").
+						Return(nil),
+				)
+				return nRunner
+			},
+			wantErr: true,
+		},
+		{
+			name:  "Does Not Remove Control Planes",
+			nodes: 0,
+			nRunner: func(ctrl *gomock.Controller) Cluster {
+				nRunner := NewMockCluster(ctrl)
+				nRunner.EXPECT().
+					Get("cluster").
+					Return(threeNodes())
+				nRunner.EXPECT().
+					Status(gomock.Any(), gomock.Any()).
+					Return(nil, nil)
+				return nRunner
+			},
+			wantErr: true,
+		},
+		{
+			name:  "Unchanged",
+			nodes: 3,
+			nRunner: func(ctrl *gomock.Controller) Cluster {
+				nRunner := NewMockCluster(ctrl)
+				nRunner.EXPECT().
+					Get("cluster").
+					Return(threeNodes())
+				nRunner.EXPECT().
+					Status(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("status error"))
+				return nRunner
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, name := range tt.attached {
+				if err := SetNodeAttached("cluster", name, true); err != nil {
+					t.Fatalf("SetNodeAttached() error = %v", err)
+				}
+			}
+			t.Cleanup(func() { os.Remove(attachedNodesPath("cluster")) })
+
+			ctrl := gomock.NewController(t)
+			e := &MinikubeClient{
+				clusterName:    "cluster",
+				TfCreationLock: &sync.RWMutex{},
+				nRunner:        tt.nRunner(ctrl),
+			}
+			if err := e.ScaleNodes(context.Background(), tt.nodes); (err != nil) != tt.wantErr {
+				t.Errorf("MinikubeClient.ScaleNodes() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestMinikubeClient_AddNode(t *testing.T) {
+	singleNode := func() *config.ClusterConfig {
+		return &config.ClusterConfig{
+			Name: "cluster",
+			KubernetesConfig: config.KubernetesConfig{
+				KubernetesVersion: "v1.30.0",
+				ContainerRuntime:  "docker",
+			},
+			Nodes: []config.Node{
+				{Name: "", ControlPlane: true, Worker: true},
+			},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		options  NodeOptions
+		nRunner  func(ctrl *gomock.Controller) Cluster
+		wantName string
+		wantErr  bool
+	}{
+		{
+			name:    "Adds Worker With Cluster Defaults",
+			options: NodeOptions{Role: RoleWorker},
+			nRunner: func(ctrl *gomock.Controller) Cluster {
+				nRunner := NewMockCluster(ctrl)
+				gomock.InOrder(
+					nRunner.EXPECT().
+						Get("cluster").
+						Return(singleNode()),
+					nRunner.EXPECT().
+						AddWorkerNode(gomock.Any(), gomock.Any(), "v1.30.0", gomock.Any(), "docker").
+						Return(nil),
+					nRunner.EXPECT().
+						Get("cluster").
+						Return(&config.ClusterConfig{
+							Name: "cluster",
+							Nodes: []config.Node{
+								{Name: "", ControlPlane: true, Worker: true},
+								{Name: "m02", IP: "192.168.49.3", Worker: true},
+							},
+						}),
+				)
+				return nRunner
+			},
+			wantName: "m02",
+			wantErr:  false,
+		},
+		{
+			name:    "Rejects Control Plane On Non HA Cluster",
+			options: NodeOptions{Role: RoleControlPlane},
+			nRunner: func(ctrl *gomock.Controller) Cluster {
+				nRunner := NewMockCluster(ctrl)
+				nRunner.EXPECT().
+					Get("cluster").
+					Return(singleNode())
+				return nRunner
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			e := &MinikubeClient{
+				clusterName:    "cluster",
+				TfCreationLock: &sync.RWMutex{},
+				nRunner:        tt.nRunner(ctrl),
+			}
+			t.Cleanup(func() { os.Remove(attachedNodesPath("cluster")) })
+
+			got, err := e.AddNode(context.Background(), tt.options)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MinikubeClient.AddNode() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if got.Name != tt.wantName {
+				t.Errorf("MinikubeClient.AddNode() = %v, want %v", got.Name, tt.wantName)
+			}
+			if attached, _ := AttachedNodes("cluster"); !reflect.DeepEqual(attached, []string{tt.wantName}) {
+				t.Errorf("AttachedNodes() = %v, want the added node %v", attached, tt.wantName)
+			}
+		})
+	}
+}
+
+func TestMinikubeClient_RemoveNode(t *testing.T) {
+	tests := []struct {
+		name     string
+		nodeName string
+		nRunner  func(ctrl *gomock.Controller) Cluster
+		wantErr  bool
+		notFound bool
+	}{
+		{
+			name:     "Removes Node",
+			nodeName: "m02",
+			nRunner: func(ctrl *gomock.Controller) Cluster {
+				nRunner := NewMockCluster(ctrl)
+				nRunner.EXPECT().
+					Get("cluster").
+					Return(&config.ClusterConfig{
+						Nodes: []config.Node{{Name: ""}, {Name: "m02"}},
+					})
+				nRunner.EXPECT().
+					DeleteNode(gomock.Any(), gomock.Any(), "m02").
+					Return(nil)
+				return nRunner
+			},
+			wantErr: false,
+		},
+		{
+			name:     "Unknown Node",
+			nodeName: "m05",
+			nRunner: func(ctrl *gomock.Controller) Cluster {
+				nRunner := NewMockCluster(ctrl)
+				nRunner.EXPECT().
+					Get("cluster").
+					Return(&config.ClusterConfig{
+						Nodes: []config.Node{{Name: ""}, {Name: "m02"}},
+					})
+				return nRunner
+			},
+			wantErr: true,
+		},
+		{
+			name:     "Deleted Cluster",
+			nodeName: "m02",
+			nRunner: func(ctrl *gomock.Controller) Cluster {
+				nRunner := NewMockCluster(ctrl)
+				nRunner.EXPECT().
+					Get("cluster").
+					Return(nil)
+				return nRunner
+			},
+			wantErr:  true,
+			notFound: true,
+		},
+		{
+			name:     "Primary Control Plane",
+			nodeName: "",
+			nRunner: func(ctrl *gomock.Controller) Cluster {
+				return NewMockCluster(ctrl)
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			e := &MinikubeClient{
+				clusterName:    "cluster",
+				TfCreationLock: &sync.RWMutex{},
+				nRunner:        tt.nRunner(ctrl),
+			}
+			if err := SetNodeAttached("cluster", "m02", true); err != nil {
+				t.Fatalf("SetNodeAttached() error = %v", err)
+			}
+			t.Cleanup(func() { os.Remove(attachedNodesPath("cluster")) })
+
+			err := e.RemoveNode(context.Background(), tt.nodeName)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MinikubeClient.RemoveNode() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if errors.Is(err, ErrClusterNotFound) != tt.notFound {
+				t.Errorf("MinikubeClient.RemoveNode() error = %v, want ErrClusterNotFound %v", err, tt.notFound)
+			}
+			if attached, _ := AttachedNodes("cluster"); !tt.wantErr && len(attached) != 0 {
+				t.Errorf("AttachedNodes() = %v, want the removed node to be forgotten", attached)
+			}
+		})
+	}
+}
+
+func TestMinikubeClient_AttachNode(t *testing.T) {
+	tests := []struct {
+		name     string
+		nodeName string
+		cc       *config.ClusterConfig
+		wantErr  bool
+	}{
+		{
+			name:     "Attaches Node",
+			nodeName: "m02",
+			cc:       &config.ClusterConfig{Nodes: []config.Node{{Name: ""}, {Name: "m02"}}},
+			wantErr:  false,
+		},
+		{
+			name:     "Unknown Node",
+			nodeName: "m05",
+			cc:       &config.ClusterConfig{Nodes: []config.Node{{Name: ""}, {Name: "m02"}}},
+			wantErr:  true,
+		},
+		{
+			name:     "Deleted Cluster",
+			nodeName: "m02",
+			cc:       nil,
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			nRunner := NewMockCluster(ctrl)
+			nRunner.EXPECT().
+				Get("cluster").
+				Return(tt.cc)
+
+			e := &MinikubeClient{
+				clusterName:    "cluster",
+				TfCreationLock: &sync.RWMutex{},
+				nRunner:        nRunner,
+			}
+			t.Cleanup(func() { os.Remove(attachedNodesPath("cluster")) })
+
+			if err := e.AttachNode(context.Background(), tt.nodeName); (err != nil) != tt.wantErr {
+				t.Errorf("MinikubeClient.AttachNode() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			attached, err := e.GetAttachedNodes()
+			if err != nil {
+				t.Fatalf("MinikubeClient.GetAttachedNodes() error = %v", err)
+			}
+			if want := !tt.wantErr; want != reflect.DeepEqual(attached, []string{tt.nodeName}) {
+				t.Errorf("MinikubeClient.GetAttachedNodes() = %v, want %s attached %v", attached, tt.nodeName, want)
+			}
+		})
+	}
+}
+
+func TestMinikubeClient_Resize(t *testing.T) {
+	tests := []struct {
+		name    string
+		nRunner func(ctrl *gomock.Controller) Cluster
+		wantErr bool
+	}{
+		{
+			name: "Stops Before Resizing",
+			nRunner: func(ctrl *gomock.Controller) Cluster {
+				nRunner := NewMockCluster(ctrl)
+				existing := &config.ClusterConfig{Driver: Docker, CPUs: 2, Memory: 4096}
+				nRunner.EXPECT().
+					Get("cluster").
+					Return(existing)
+				gomock.InOrder(
+					nRunner.EXPECT().
+						Stop(gomock.Any(), existing).
+						Return(nil),
+					nRunner.EXPECT().
+						Resize(gomock.Any(), existing, 4, 8192).
+						Return(nil),
+				)
+				return nRunner
+			},
+			wantErr: false,
+		},
+		{
+			name: "Stop Failure",
+			nRunner: func(ctrl *gomock.Controller) Cluster {
+				nRunner := NewMockCluster(ctrl)
+				nRunner.EXPECT().
+					Get("cluster").
+					Return(&config.ClusterConfig{Driver: Docker})
+				nRunner.EXPECT().
+					Stop(gomock.Any(), gomock.Any()).
+					Return(errors.New("stop failed"))
+				return nRunner
+			},
+			wantErr: true,
+		},
+		{
+			name: "Missing Cluster",
+			nRunner: func(ctrl *gomock.Controller) Cluster {
+				nRunner := NewMockCluster(ctrl)
+				nRunner.EXPECT().
+					Get("cluster").
+					Return(nil)
+				return nRunner
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			e := &MinikubeClient{
+				clusterConfig:  &config.ClusterConfig{CPUs: 4, Memory: 8192},
+				clusterName:    "cluster",
+				TfCreationLock: &sync.RWMutex{},
+				nRunner:        tt.nRunner(ctrl),
+			}
+			if err := e.Resize(context.Background()); (err != nil) != tt.wantErr {
+				t.Errorf("MinikubeClient.Resize() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
 func TestMinikubeClient_Delete(t *testing.T) {
 	type fields struct {
 		clusterConfig   config.ClusterConfig
@@ -305,6 +957,38 @@ func TestMinikubeClient_Delete(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "Directories Left Behind",
+			fields: fields{
+				clusterConfig: config.ClusterConfig{
+					Nodes: []config.Node{
+						{},
+					},
+				},
+				addons:          []string{},
+				isoUrls:         []string{},
+				deleteOnFailure: true,
+				nRunner:         getDeleteLeftovers(ctrl),
+				dLoader:         &MockDownloader{},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Already Gone",
+			fields: fields{
+				clusterConfig: config.ClusterConfig{
+					Nodes: []config.Node{
+						{},
+					},
+				},
+				addons:          []string{},
+				isoUrls:         []string{},
+				deleteOnFailure: true,
+				nRunner:         getDeleteAlreadyGone(ctrl),
+				dLoader:         &MockDownloader{},
+			},
+			wantErr: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -316,14 +1000,146 @@ func TestMinikubeClient_Delete(t *testing.T) {
 				deleteOnFailure: tt.fields.deleteOnFailure,
 				nRunner:         tt.fields.nRunner,
 				dLoader:         tt.fields.dLoader,
+				kubeconfigPath:  filepath.Join(t.TempDir(), "config"),
 			}
-			if err := e.Delete(); (err != nil) != tt.wantErr {
+			if err := e.Delete(context.Background()); (err != nil) != tt.wantErr {
 				t.Errorf("MinikubeClient.Delete() error = %v, wantErr %v", err, tt.wantErr)
 			}
 		})
 	}
 }
 
+func TestMinikubeClient_DeleteRestoresContext(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config")
+	writeKubeconfig(t, path, "cluster", "cluster", "other")
+
+	ctrl := gomock.NewController(t)
+	e := &MinikubeClient{
+		clusterConfig:   &config.ClusterConfig{},
+		clusterName:     "cluster",
+		kubeconfigPath:  path,
+		previousContext: "other",
+		nRunner:         getDeleteSuccess(ctrl),
+	}
+	if err := e.Delete(context.Background()); err != nil {
+		t.Fatalf("MinikubeClient.Delete() error = %v", err)
+	}
+
+	cfg, err := clientcmd.LoadFromFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.CurrentContext != "other" {
+		t.Errorf("CurrentContext = %v, want other", cfg.CurrentContext)
+	}
+	if _, ok := cfg.Contexts["cluster"]; ok {
+		t.Errorf("the context of the deleted cluster was left behind")
+	}
+}
+
+func TestMinikubeClient_DeleteUsesKubeconfigPath(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config")
+	writeKubeconfig(t, path, "cluster", "cluster")
+
+	ctrl := gomock.NewController(t)
+	nRunner := NewMockCluster(ctrl)
+	nRunner.EXPECT().
+		Get(gomock.Any()).
+		Return(nil)
+	nRunner.EXPECT().
+		Delete(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, cc *config.ClusterConfig, name string) (*config.Node, error) {
+			if got := os.Getenv(clientcmd.RecommendedConfigPathEnvVar); got != path {
+				t.Errorf("%s = %v during delete, want %v", clientcmd.RecommendedConfigPathEnvVar, got, path)
+			}
+			return nil, nil
+		})
+	nRunner.EXPECT().
+		Leftovers(gomock.Any(), gomock.Any()).
+		Return(nil)
+
+	e := &MinikubeClient{
+		clusterConfig:  &config.ClusterConfig{},
+		clusterName:    "cluster",
+		kubeconfigPath: path,
+		nRunner:        nRunner,
+	}
+	if err := e.Delete(context.Background()); err != nil {
+		t.Fatalf("MinikubeClient.Delete() error = %v", err)
+	}
+	if got := os.Getenv(clientcmd.RecommendedConfigPathEnvVar); got == path {
+		t.Errorf("%s was not restored after delete", clientcmd.RecommendedConfigPathEnvVar)
+	}
+}
+
+func TestMinikubeClient_Purge(t *testing.T) {
+	tests := []struct {
+		name    string
+		deps    func(ctrl *gomock.Controller, existing *config.ClusterConfig) (Cluster, Downloader)
+		wantErr bool
+	}{
+		{
+			name: "Purges The Cache Of The Deleted Cluster",
+			deps: func(ctrl *gomock.Controller, existing *config.ClusterConfig) (Cluster, Downloader) {
+				nRunner := NewMockCluster(ctrl)
+				dLoader := NewMockDownloader(ctrl)
+				nRunner.EXPECT().
+					Get("cluster").
+					Return(existing).
+					Times(2)
+				nRunner.EXPECT().
+					Leftovers(existing, "cluster").
+					Return(nil)
+				gomock.InOrder(
+					nRunner.EXPECT().
+						Delete(gomock.Any(), existing, "cluster").
+						Return(nil, nil),
+					dLoader.EXPECT().
+						Purge(gomock.Any(), existing).
+						Return(nil),
+				)
+				return nRunner, dLoader
+			},
+			wantErr: false,
+		},
+		{
+			name: "Keeps The Cache When Delete Fails",
+			deps: func(ctrl *gomock.Controller, existing *config.ClusterConfig) (Cluster, Downloader) {
+				nRunner := NewMockCluster(ctrl)
+				nRunner.EXPECT().
+					Get("cluster").
+					Return(existing).
+					Times(2)
+				nRunner.EXPECT().
+					Delete(gomock.Any(), existing, "cluster").
+					Return(nil, errors.New("delete error"))
+				nRunner.EXPECT().
+					Leftovers(existing, "cluster").
+					Return([]string{"/minikube/machines/cluster"})
+				return nRunner, NewMockDownloader(ctrl)
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			existing := &config.ClusterConfig{MinikubeISO: "https://example.com/minikube.iso"}
+			nRunner, dLoader := tt.deps(ctrl, existing)
+			e := &MinikubeClient{
+				clusterConfig:  &config.ClusterConfig{},
+				clusterName:    "cluster",
+				kubeconfigPath: filepath.Join(t.TempDir(), "config"),
+				nRunner:        nRunner,
+				dLoader:        dLoader,
+			}
+			if err := e.Purge(context.Background()); (err != nil) != tt.wantErr {
+				t.Errorf("MinikubeClient.Purge() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
 func TestNewMinikubeClient(t *testing.T) {
 	type args struct {
 		args MinikubeClientConfig
@@ -361,7 +1177,7 @@ func TestMinikubeClient_SetConfig(t *testing.T) {
 		isoUrls         []string
 		deleteOnFailure bool
 		nodes           int
-		TfCreationLock  *sync.Mutex
+		TfCreationLock  *sync.RWMutex
 		K8sVersion      string
 		nRunner         Cluster
 		dLoader         Downloader
@@ -418,7 +1234,7 @@ func TestMinikubeClient_SetDependencies(t *testing.T) {
 		isoUrls         []string
 		deleteOnFailure bool
 		nodes           int
-		TfCreationLock  *sync.Mutex
+		TfCreationLock  *sync.RWMutex
 		K8sVersion      string
 		nRunner         Cluster
 		dLoader         Downloader
@@ -469,7 +1285,7 @@ func TestMinikubeClient_GetConfig(t *testing.T) {
 		isoUrls         []string
 		deleteOnFailure bool
 		nodes           int
-		TfCreationLock  *sync.Mutex
+		TfCreationLock  *sync.RWMutex
 		K8sVersion      string
 		nRunner         Cluster
 		dLoader         Downloader
@@ -528,7 +1344,7 @@ func TestMinikubeClient_ApplyAddons(t *testing.T) {
 		isoUrls         []string
 		deleteOnFailure bool
 		nodes           int
-		TfCreationLock  *sync.Mutex
+		TfCreationLock  *sync.RWMutex
 		K8sVersion      string
 		dLoader         Downloader
 	}
@@ -548,7 +1364,7 @@ func TestMinikubeClient_ApplyAddons(t *testing.T) {
 			fields: fields{
 				clusterName:    "cluster",
 				addons:         []string{"feature1", "feature2"},
-				TfCreationLock: &sync.Mutex{},
+				TfCreationLock: &sync.RWMutex{},
 			},
 			args: args{
 				addons: []string{"feature1"},
@@ -561,7 +1377,7 @@ func TestMinikubeClient_ApplyAddons(t *testing.T) {
 			fields: fields{
 				clusterName:    "cluster",
 				addons:         []string{"feature1", "feature2"},
-				TfCreationLock: &sync.Mutex{},
+				TfCreationLock: &sync.RWMutex{},
 			},
 			args: args{
 				addons: []string{"feature1", "feature2", "feature3"},
@@ -574,7 +1390,7 @@ func TestMinikubeClient_ApplyAddons(t *testing.T) {
 			fields: fields{
 				clusterName:    "cluster",
 				addons:         []string{"feature1", "feature2"},
-				TfCreationLock: &sync.Mutex{},
+				TfCreationLock: &sync.RWMutex{},
 			},
 			args: args{
 				addons: []string{"feature3"},
@@ -588,7 +1404,7 @@ func TestMinikubeClient_ApplyAddons(t *testing.T) {
 			fields: fields{
 				clusterName:    "cluster",
 				addons:         []string{"feature1", "feature2"},
-				TfCreationLock: &sync.Mutex{},
+				TfCreationLock: &sync.RWMutex{},
 			},
 			args: args{
 				addons: []string{"feature3"},
@@ -604,17 +1420,17 @@ func TestMinikubeClient_ApplyAddons(t *testing.T) {
 			addSeq := make([]*gomock.Call, 0)
 			if tt.wantErr {
 				delSeq = append(delSeq, mockNode.EXPECT().
-					SetAddon(gomock.Any(), gomock.Any(), gomock.Any()).
+					SetAddon(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 					Return(errors.New("error")))
 			} else {
 				for _, deleteAddon := range tt.deleteAddons {
 					delSeq = append(delSeq, mockNode.EXPECT().
-						SetAddon("cluster", deleteAddon, "false").
+						SetAddon(gomock.Any(), "cluster", deleteAddon, "false").
 						Return(nil))
 				}
 				for _, addAddon := range tt.addAddons {
 					addSeq = append(addSeq, mockNode.EXPECT().
-						SetAddon("cluster", addAddon, "true").
+						SetAddon(gomock.Any(), "cluster", addAddon, "true").
 						Return(nil))
 				}
 			}
@@ -632,7 +1448,7 @@ func TestMinikubeClient_ApplyAddons(t *testing.T) {
 				nRunner:         mockNode,
 				dLoader:         tt.fields.dLoader,
 			}
-			if err := e.ApplyAddons(tt.args.addons); (err != nil) != tt.wantErr {
+			if err := e.ApplyAddons(context.Background(), tt.args.addons); (err != nil) != tt.wantErr {
 				t.Errorf("MinikubeClient.EnableAddons() error = %v, wantErr %v", err, tt.wantErr)
 			}
 		})
@@ -747,7 +1563,7 @@ func TestMinikubeClient_ContainerMounts(t *testing.T) {
 				nRunner:         getNodeSuccess(ctrl),
 				dLoader:         getDownloadSuccess(ctrl),
 			}
-			e.Start()
+			e.Start(context.Background())
 			got := e.clusterConfig.ContainerVolumeMounts
 			if !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("clusterConfig.ContainerVolumeMounts = %v, want %v", got, tt.want)
@@ -756,11 +1572,183 @@ func TestMinikubeClient_ContainerMounts(t *testing.T) {
 	}
 }
 
+func TestMinikubeClient_ConcurrentReads(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	lock := &sync.RWMutex{}
+
+	// Each refresh waits for the other, so both only finish if they hold the lock at the same time
+	var entered sync.WaitGroup
+	entered.Add(2)
+	both := make(chan struct{})
+	go func() {
+		entered.Wait()
+		close(both)
+	}()
+
+	clients := make([]*MinikubeClient, 0)
+	for _, name := range []string{"cluster-a", "cluster-b"} {
+		nRunner := NewMockCluster(ctrl)
+		nRunner.EXPECT().
+			Status(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, cc *config.ClusterConfig) ([]*cluster.Status, error) {
+				entered.Done()
+				select {
+				case <-both:
+					return nil, nil
+				case <-time.After(5 * time.Second):
+					return nil, errors.New("refreshes of different clusters did not run in parallel")
+				}
+			})
+
+		clients = append(clients, &MinikubeClient{clusterName: name, TfCreationLock: lock, nRunner: nRunner})
+	}
+
+	errs := make(chan error, len(clients))
+	for _, client := range clients {
+		go func(client *MinikubeClient) {
+			_, err := client.GetStatus(context.Background(), &config.ClusterConfig{Name: client.clusterName})
+			errs <- err
+		}(client)
+	}
+
+	for range clients {
+		if err := <-errs; err != nil {
+			t.Errorf("MinikubeClient.GetStatus() error = %v", err)
+		}
+	}
+}
+
+func TestMinikubeClient_ConcurrentWrites(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	lock := &sync.RWMutex{}
+	kubeconfigPath := filepath.Join(t.TempDir(), "config")
+
+	// Count the operations in flight, while viper lets the race detector catch anything the counters miss
+	var writers, readers int32
+	write := func(name string) {
+		if atomic.AddInt32(&writers, 1) != 1 || atomic.LoadInt32(&readers) != 0 {
+			t.Errorf("changing %s overlapped another operation", name)
+		}
+		viper.Set(config.ProfileName, name)
+		time.Sleep(time.Millisecond)
+		atomic.AddInt32(&writers, -1)
+	}
+	read := func(name string) {
+		atomic.AddInt32(&readers, 1)
+		if atomic.LoadInt32(&writers) != 0 {
+			t.Errorf("refreshing %s overlapped a change", name)
+		}
+		viper.GetString(config.ProfileName)
+		time.Sleep(time.Millisecond)
+		atomic.AddInt32(&readers, -1)
+	}
+
+	nRunner := NewMockCluster(ctrl)
+	nRunner.EXPECT().
+		Get(gomock.Any()).
+		DoAndReturn(func(name string) *config.ClusterConfig {
+			return &config.ClusterConfig{Name: name}
+		}).
+		AnyTimes()
+	nRunner.EXPECT().
+		Delete(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, cc *config.ClusterConfig, name string) (*config.Node, error) {
+			write(name)
+			return nil, nil
+		}).
+		AnyTimes()
+	nRunner.EXPECT().
+		Leftovers(gomock.Any(), gomock.Any()).
+		Return(nil).
+		AnyTimes()
+	nRunner.EXPECT().
+		SetAddon(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, name string, addon string, value string) error {
+			write(name)
+			return nil
+		}).
+		AnyTimes()
+	nRunner.EXPECT().
+		Status(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, cc *config.ClusterConfig) ([]*cluster.Status, error) {
+			read(cc.Name)
+			return nil, nil
+		}).
+		AnyTimes()
+
+	newClient := func(name string) *MinikubeClient {
+		return &MinikubeClient{
+			clusterConfig:  &config.ClusterConfig{Name: name},
+			clusterName:    name,
+			kubeconfigPath: kubeconfigPath,
+			TfCreationLock: lock,
+			nRunner:        nRunner,
+		}
+	}
+
+	// Destroy one cluster while the other is changed and both are refreshed
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(4)
+		go func() {
+			defer wg.Done()
+			if err := newClient("cluster-a").Delete(context.Background()); err != nil {
+				t.Errorf("MinikubeClient.Delete() error = %v", err)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			if err := newClient("cluster-b").ApplyAddons(context.Background(), []string{"dashboard"}); err != nil {
+				t.Errorf("MinikubeClient.ApplyAddons() error = %v", err)
+			}
+		}()
+		for _, name := range []string{"cluster-a", "cluster-b"} {
+			go func(name string) {
+				defer wg.Done()
+				client := newClient(name)
+				if _, err := client.GetStatus(context.Background(), client.GetClusterConfig()); err != nil {
+					t.Errorf("MinikubeClient.GetStatus() error = %v", err)
+				}
+			}(name)
+		}
+	}
+	wg.Wait()
+}
+
+func TestUseMinikubeHome(t *testing.T) {
+	t.Setenv("MINIKUBE_HOME", "")
+	t.Setenv("PATH", "/usr/bin")
+	addMinikubeBinToPath()
+
+	first := t.TempDir()
+	if err := UseMinikubeHome(first); err != nil {
+		t.Fatalf("UseMinikubeHome() error = %v", err)
+	}
+
+	second := t.TempDir()
+	if err := UseMinikubeHome(second); err != nil {
+		t.Fatalf("UseMinikubeHome() error = %v", err)
+	}
+
+	if got, want := localpath.MiniPath(), filepath.Join(second, ".minikube"); got != want {
+		t.Errorf("localpath.MiniPath() = %v, want %v", got, want)
+	}
+
+	want := strings.Join([]string{filepath.Join(second, ".minikube", "bin"), "/usr/bin"}, string(os.PathListSeparator))
+	if got := os.Getenv("PATH"); got != want {
+		t.Errorf("PATH = %v, want %v, without the bin directories of previous homes", got, want)
+	}
+
+	if err := UseMinikubeHome(""); err != nil || localpath.MiniPath() != filepath.Join(second, ".minikube") {
+		t.Errorf("UseMinikubeHome() changed MINIKUBE_HOME without a home being set")
+	}
+}
+
 func getProvisionerFailure(ctrl *gomock.Controller) Cluster {
 	nRunnerProvisionFailure := NewMockCluster(ctrl)
 
 	nRunnerProvisionFailure.EXPECT().
-		Provision(gomock.Any(), gomock.Any(), gomock.Any()).
+		Provision(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return(nil, false, nil, nil, errors.New("provision error"))
 
 	return nRunnerProvisionFailure
@@ -770,11 +1758,11 @@ func getStartFailure(ctrl *gomock.Controller) Cluster {
 	nRunnerStartFailure := NewMockCluster(ctrl)
 
 	nRunnerStartFailure.EXPECT().
-		Provision(gomock.Any(), gomock.Any(), gomock.Any()).
+		Provision(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return(nil, false, nil, nil, nil)
 
 	nRunnerStartFailure.EXPECT().
-		Start(gomock.Any()).
+		Start(gomock.Any(), gomock.Any()).
 		Return(nil, errors.New("start error"))
 
 	return nRunnerStartFailure
@@ -784,7 +1772,7 @@ func getDownloadFailure(ctrl *gomock.Controller) Downloader {
 	dLoaderFailure := NewMockDownloader(ctrl)
 
 	dLoaderFailure.EXPECT().
-		ISO(gomock.Any(), gomock.Any()).
+		ISO(gomock.Any(), gomock.Any(), gomock.Any()).
 		Return("", errors.New("download error"))
 
 	return dLoaderFailure
@@ -794,11 +1782,11 @@ func getTarballFailure(ctrl *gomock.Controller) Downloader {
 	dLoaderSuccess := NewMockDownloader(ctrl)
 
 	dLoaderSuccess.EXPECT().
-		ISO(gomock.Any(), gomock.Any()).
+		ISO(gomock.Any(), gomock.Any(), gomock.Any()).
 		Return("https://mock_iso_url/iso.iso", nil)
 
 	dLoaderSuccess.EXPECT().
-		PreloadTarball(gomock.Any(), gomock.Any(), gomock.Any()).
+		PreloadTarball(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return(errors.New("tar ball failure"))
 
 	return dLoaderSuccess
@@ -808,15 +1796,15 @@ func getNodeSuccess(ctrl *gomock.Controller) Cluster {
 	nRunnerSuccess := NewMockCluster(ctrl)
 
 	nRunnerSuccess.EXPECT().
-		Provision(gomock.Any(), gomock.Any(), gomock.Any()).
+		Provision(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return(nil, false, nil, nil, nil)
 
 	nRunnerSuccess.EXPECT().
-		Start(gomock.Any()).
+		Start(gomock.Any(), gomock.Any()).
 		Return(nil, nil)
 
 	nRunnerSuccess.EXPECT().
-		SetAddon(gomock.Any(), gomock.Any(), gomock.Any()).
+		SetAddon(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return(nil).
 		AnyTimes()
 
@@ -827,20 +1815,20 @@ func getMultipleNodesSuccess(ctrl *gomock.Controller, n int) Cluster {
 	nRunnerSuccess := NewMockCluster(ctrl)
 
 	nRunnerSuccess.EXPECT().
-		Provision(gomock.Any(), gomock.Any(), gomock.Any()).
+		Provision(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return(nil, false, nil, nil, nil)
 
 	nRunnerSuccess.EXPECT().
-		Start(gomock.Any()).
+		Start(gomock.Any(), gomock.Any()).
 		Return(nil, nil)
 
 	nRunnerSuccess.EXPECT().
-		AddWorkerNode(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		AddWorkerNode(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return(nil).
 		Times(n - 1)
 
 	nRunnerSuccess.EXPECT().
-		SetAddon(gomock.Any(), gomock.Any(), gomock.Any()).
+		SetAddon(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return(nil).
 		AnyTimes()
 
@@ -851,15 +1839,15 @@ func getMultipleNodesFailure(ctrl *gomock.Controller) Cluster {
 	nRunnerSuccess := NewMockCluster(ctrl)
 
 	nRunnerSuccess.EXPECT().
-		Provision(gomock.Any(), gomock.Any(), gomock.Any()).
+		Provision(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return(nil, false, nil, nil, nil)
 
 	nRunnerSuccess.EXPECT().
-		Start(gomock.Any()).
+		Start(gomock.Any(), gomock.Any()).
 		Return(nil, nil)
 
 	nRunnerSuccess.EXPECT().
-		AddWorkerNode(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		AddWorkerNode(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return(errors.New("error adding node"))
 
 	return nRunnerSuccess
@@ -869,30 +1857,30 @@ func getHANodes(ctrl *gomock.Controller, haNodes int, nodes int, cc *config.Clus
 	nRunnerSuccess := NewMockCluster(ctrl)
 
 	nRunnerSuccess.EXPECT().
-		Provision(gomock.Any(), gomock.Any(), gomock.Any()).
+		Provision(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return(nil, false, nil, nil, nil)
 
 	nRunnerSuccess.EXPECT().
-		Start(gomock.Any()).
+		Start(gomock.Any(), gomock.Any()).
 		Return(nil, nil)
 
 	if haNodes > 0 {
 		nRunnerSuccess.EXPECT().
-			AddControlPlaneNode(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			AddControlPlaneNode(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(cc, nil).
 			Times(haNodes)
 	}
 
 	if nodes > 0 {
 		nRunnerSuccess.EXPECT().
-			AddWorkerNode(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			AddWorkerNode(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(nil).
 			Times(nodes)
 	}
 
 	if !wantErr {
 		nRunnerSuccess.EXPECT().
-			SetAddon(gomock.Any(), gomock.Any(), gomock.Any()).
+			SetAddon(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(nil).
 			AnyTimes()
 	}
@@ -900,15 +1888,38 @@ func getHANodes(ctrl *gomock.Controller, haNodes int, nodes int, cc *config.Clus
 	return nRunnerSuccess
 }
 
+func getRestartSuccess(ctrl *gomock.Controller, existing *config.ClusterConfig) Cluster {
+	nRunnerSuccess := NewMockCluster(ctrl)
+
+	nRunnerSuccess.EXPECT().
+		Get(gomock.Any()).
+		Return(existing)
+
+	nRunnerSuccess.EXPECT().
+		Provision(gomock.Any(), gomock.Any(), gomock.Any(), false).
+		Return(nil, true, nil, nil, nil)
+
+	nRunnerSuccess.EXPECT().
+		Start(gomock.Any(), gomock.Any()).
+		Return(nil, nil)
+
+	nRunnerSuccess.EXPECT().
+		StartNode(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil).
+		Times(len(existing.Nodes) - 1)
+
+	return nRunnerSuccess
+}
+
 func getDownloadSuccess(ctrl *gomock.Controller) Downloader {
 	dLoaderSuccess := NewMockDownloader(ctrl)
 
 	dLoaderSuccess.EXPECT().
-		ISO(gomock.Any(), gomock.Any()).
+		ISO(gomock.Any(), gomock.Any(), gomock.Any()).
 		Return("https://mock_iso_url/iso.iso", nil)
 
 	dLoaderSuccess.EXPECT().
-		PreloadTarball(gomock.Any(), gomock.Any(), gomock.Any()).
+		PreloadTarball(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return(nil)
 
 	return dLoaderSuccess
@@ -918,9 +1929,17 @@ func getDeleteSuccess(ctrl *gomock.Controller) Cluster {
 	nRunnerSuccess := NewMockCluster(ctrl)
 
 	nRunnerSuccess.EXPECT().
-		Delete(gomock.Any(), gomock.Any()).
+		Get(gomock.Any()).
+		Return(nil)
+
+	nRunnerSuccess.EXPECT().
+		Delete(gomock.Any(), gomock.Any(), gomock.Any()).
 		Return(nil, nil)
 
+	nRunnerSuccess.EXPECT().
+		Leftovers(gomock.Any(), gomock.Any()).
+		Return(nil)
+
 	return nRunnerSuccess
 }
 
@@ -928,8 +1947,89 @@ func getDeleteFailure(ctrl *gomock.Controller) Cluster {
 	nRunnerSuccess := NewMockCluster(ctrl)
 
 	nRunnerSuccess.EXPECT().
-		Delete(gomock.Any(), gomock.Any()).
+		Get(gomock.Any()).
+		Return(nil)
+
+	nRunnerSuccess.EXPECT().
+		Delete(gomock.Any(), gomock.Any(), gomock.Any()).
 		Return(nil, errors.New("delete error"))
 
+	nRunnerSuccess.EXPECT().
+		Leftovers(gomock.Any(), gomock.Any()).
+		Return([]string{"/minikube/machines/cluster"})
+
 	return nRunnerSuccess
 }
+
+func getDeleteLeftovers(ctrl *gomock.Controller) Cluster {
+	nRunner := NewMockCluster(ctrl)
+
+	nRunner.EXPECT().
+		Get(gomock.Any()).
+		Return(nil)
+
+	nRunner.EXPECT().
+		Delete(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil, nil)
+
+	nRunner.EXPECT().
+		Leftovers(gomock.Any(), gomock.Any()).
+		Return([]string{"/minikube/machines/cluster", "/minikube/profiles/cluster"})
+
+	return nRunner
+}
+
+func getDeleteAlreadyGone(ctrl *gomock.Controller) Cluster {
+	nRunner := NewMockCluster(ctrl)
+
+	nRunner.EXPECT().
+		Get(gomock.Any()).
+		Return(nil)
+
+	nRunner.EXPECT().
+		Delete(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil, errors.New("profile not found"))
+
+	nRunner.EXPECT().
+		Leftovers(gomock.Any(), gomock.Any()).
+		Return(nil)
+
+	return nRunner
+}
+
+func TestMinikubeClient_WritesHoldProfileLock(t *testing.T) {
+	held, err := acquireLock(context.Background(), profileLockPath("cluster"), true, 0, "start of cluster cluster")
+	if err != nil {
+		t.Fatalf("acquireLock() error = %v", err)
+	}
+	defer held.Unlock()
+
+	e := &MinikubeClient{
+		clusterConfig:  &config.ClusterConfig{},
+		clusterName:    "cluster",
+		TfCreationLock: &sync.RWMutex{},
+		nRunner:        NewMockCluster(gomock.NewController(t)),
+	}
+
+	ctx := context.Background()
+	writes := map[string]func() error{
+		"ScaleNodes":   func() error { return e.ScaleNodes(ctx, 2) },
+		"AddNode":      func() error { _, err := e.AddNode(ctx, NodeOptions{}); return err },
+		"RemoveNode":   func() error { return e.RemoveNode(ctx, "m02") },
+		"AttachNode":   func() error { return e.AttachNode(ctx, "m02") },
+		"Resize":       func() error { return e.Resize(ctx) },
+		"Stop":         func() error { return e.Stop(ctx) },
+		"Pause":        func() error { return e.Pause(ctx) },
+		"Unpause":      func() error { return e.Unpause(ctx) },
+		"EnableAddon":  func() error { return e.EnableAddon(ctx, "dashboard", AddonOptions{}) },
+		"DisableAddon": func() error { return e.DisableAddon(ctx, "dashboard") },
+	}
+	for name, write := range writes {
+		t.Run(name, func(t *testing.T) {
+			var lockErr *LockTimeoutError
+			if err := write(); !errors.As(err, &lockErr) {
+				t.Errorf("MinikubeClient.%s() error = %v, want it to wait for the lock of the profile", name, err)
+			}
+		})
+	}
+}
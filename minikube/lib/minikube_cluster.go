@@ -2,42 +2,61 @@
 package lib
 
 import (
+	"context"
+	"fmt"
+	"net"
 	"os"
 	"path/filepath"
+	"strconv"
+	"strings"
 
+	"github.com/spf13/viper"
+	"k8s.io/klog/v2"
 	delete "k8s.io/minikube/cmd/minikube/cmd"
 	minikubeAddons "k8s.io/minikube/pkg/addons"
 	"k8s.io/minikube/pkg/libmachine"
 	"k8s.io/minikube/pkg/libmachine/host"
+	"k8s.io/minikube/pkg/minikube/cluster"
 	"k8s.io/minikube/pkg/minikube/command"
 	"k8s.io/minikube/pkg/minikube/config"
-	"k8s.io/minikube/pkg/minikube/exit"
+	"k8s.io/minikube/pkg/minikube/cruntime"
 	"k8s.io/minikube/pkg/minikube/kubeconfig"
 	"k8s.io/minikube/pkg/minikube/localpath"
-	"k8s.io/minikube/pkg/minikube/mustload"
+	"k8s.io/minikube/pkg/minikube/machine"
 	"k8s.io/minikube/pkg/minikube/node"
 	"k8s.io/minikube/pkg/minikube/reason"
 	"k8s.io/minikube/pkg/minikube/run"
 )
 
 type Cluster interface {
-	Provision(cc *config.ClusterConfig, n *config.Node, delOnFail bool) (command.Runner, bool, libmachine.API, *host.Host, error)
-	Start(starter node.Starter) (*kubeconfig.Settings, error)
-	Delete(cc *config.ClusterConfig, name string) (*config.Node, error)
+	Provision(ctx context.Context, cc *config.ClusterConfig, n *config.Node, delOnFail bool) (command.Runner, bool, libmachine.API, *host.Host, error)
+	Start(ctx context.Context, starter node.Starter) (*kubeconfig.Settings, error)
+	Delete(ctx context.Context, cc *config.ClusterConfig, name string) (*config.Node, error)
+	Leftovers(cc *config.ClusterConfig, name string) []string
 	Get(name string) *config.ClusterConfig
-	AddWorkerNode(cc *config.ClusterConfig, kv string, apiServerPort int, cr string) error
-	AddControlPlaneNode(cc *config.ClusterConfig, k8sVersion string, port int, containerRuntime string) (*config.ClusterConfig, error)
-	SetAddon(name string, addon string, value string) error
+	Load(name string) (*config.ClusterConfig, error)
+	Status(ctx context.Context, cc *config.ClusterConfig) ([]*cluster.Status, error)
+	NodeIPs(ctx context.Context, cc *config.ClusterConfig) (map[string]string, error)
+	Stop(ctx context.Context, cc *config.ClusterConfig) error
+	Resize(ctx context.Context, cc *config.ClusterConfig, cpus int, memory int) error
+	Pause(ctx context.Context, cc *config.ClusterConfig) error
+	Unpause(ctx context.Context, cc *config.ClusterConfig) error
+	Kubeconfig(name string, path string) (*kubeconfig.Settings, error)
+	ListProfiles() ([]Profile, error)
+	AddWorkerNode(ctx context.Context, cc *config.ClusterConfig, kv string, apiServerPort int, cr string) error
+	AddControlPlaneNode(ctx context.Context, cc *config.ClusterConfig, k8sVersion string, port int, containerRuntime string) (*config.ClusterConfig, error)
+	StartNode(ctx context.Context, cc *config.ClusterConfig, n config.Node) error
+	DeleteNode(ctx context.Context, cc *config.ClusterConfig, name string) error
+	SetAddon(ctx context.Context, name string, addon string, value string) error
+	EnableAddon(ctx context.Context, name string, addon string, options AddonOptions) error
 }
 
 type MinikubeCluster struct {
-	nodes          int
 	commandOptions *run.CommandOptions
 }
 
 func NewMinikubeCluster() *MinikubeCluster {
 	return &MinikubeCluster{
-		nodes: 0,
 		commandOptions: &run.CommandOptions{
 			NonInteractive: true,
 			DownloadOnly:   false,
@@ -45,32 +64,59 @@ func NewMinikubeCluster() *MinikubeCluster {
 	}
 }
 
-func (m *MinikubeCluster) Provision(cc *config.ClusterConfig, n *config.Node, delOnFail bool) (command.Runner, bool, libmachine.API, *host.Host, error) {
-	makeAllMinikubeDirectories()
-	_, err := node.CacheKubectlBinary(cc.KubernetesConfig.KubernetesVersion, cc.BinaryMirror)
+func (m *MinikubeCluster) Provision(ctx context.Context, cc *config.ClusterConfig, n *config.Node, delOnFail bool) (r command.Runner, s bool, l libmachine.API, h *host.Host, err error) {
+	defer recoverError(reason.GuestProvision, &err)
+
+	err = makeAllMinikubeDirectories()
 	if err != nil {
 		return nil, false, nil, nil, err
 	}
 
-	r, s, l, h, err := node.Provision(cc, n, delOnFail, m.commandOptions)
+	err = runWithContext(ctx, func() error {
+		_, err := node.CacheKubectlBinary(cc.KubernetesConfig.KubernetesVersion, cc.BinaryMirror)
+		return err
+	})
 	if err != nil {
+		return nil, false, nil, nil, wrapError(reason.InetCacheKubectl, err)
+	}
+
+	if err = ctx.Err(); err != nil {
 		return nil, false, nil, nil, err
 	}
-	m.nodes++
-	return r, s, l, h, err
-}
 
-func (m *MinikubeCluster) Start(starter node.Starter) (*kubeconfig.Settings, error) {
-	s, err := node.Start(starter, m.commandOptions)
+	r, s, l, h, err = node.Provision(cc, n, delOnFail, m.commandOptions)
 	if err != nil {
+		return nil, false, nil, nil, wrapError(reason.GuestProvision, err)
+	}
+	return r, s, l, h, nil
+}
+
+// Start starts kubernetes on the provisioned primary node. Panics and returned errors are reported with their reason.
+// Paths within node.Start that exit the process, e.g. through exit.Error, are reported by the isolated helper it runs in,
+// see GuardedClient
+func (m *MinikubeCluster) Start(ctx context.Context, starter node.Starter) (s *kubeconfig.Settings, err error) {
+	defer recoverError(reason.GuestStart, &err)
+
+	if err = ctx.Err(); err != nil {
 		return nil, err
 	}
+
+	s, err = node.Start(starter, m.commandOptions)
+	if err != nil {
+		return nil, wrapError(reason.GuestStart, err)
+	}
 	return s, nil
 }
 
-func (m *MinikubeCluster) AddControlPlaneNode(cc *config.ClusterConfig, k8sVersion string, port int, containerRuntime string) (*config.ClusterConfig, error) {
+func (m *MinikubeCluster) AddControlPlaneNode(ctx context.Context, cc *config.ClusterConfig, k8sVersion string, port int, containerRuntime string) (_ *config.ClusterConfig, err error) {
+	defer recoverError(reason.GuestNodeAdd, &err)
+
+	if err = ctx.Err(); err != nil {
+		return nil, err
+	}
+
 	n := config.Node{
-		Name:              node.Name(m.nodes + 1),
+		Name:              nextNodeName(cc),
 		Worker:            true,
 		ControlPlane:      true,
 		KubernetesVersion: k8sVersion,
@@ -78,31 +124,63 @@ func (m *MinikubeCluster) AddControlPlaneNode(cc *config.ClusterConfig, k8sVersi
 		ContainerRuntime:  containerRuntime,
 	}
 
-	err := node.Add(cc, n, false, m.commandOptions)
+	err = node.Add(cc, n, false, m.commandOptions)
 	if err != nil {
-		return nil, err
+		return nil, wrapError(reason.GuestNodeAdd, err)
 	}
 
-	m.nodes++
 	return cc, nil
 }
 
 // AddWorkerNode adds a new worker node to the clusters node pool
-func (m *MinikubeCluster) AddWorkerNode(cc *config.ClusterConfig, kv string, apiServerPort int, cr string) error {
-	m.nodes++
+func (m *MinikubeCluster) AddWorkerNode(ctx context.Context, cc *config.ClusterConfig, kv string, apiServerPort int, cr string) (err error) {
+	defer recoverError(reason.GuestNodeAdd, &err)
+
+	if err = ctx.Err(); err != nil {
+		return err
+	}
+
 	n := config.Node{
-		// index starts from 1 https://github.com/kubernetes/minikube/blob/075c1b01f2f8778ac746e05098044234a3f0b06f/pkg/minikube/driver/driver.go#L387C4-L387C27
-		Name:              node.Name(m.nodes),
+		Name:              nextNodeName(cc),
 		Worker:            true,
 		ControlPlane:      false,
 		KubernetesVersion: kv,
 		Port:              apiServerPort,
 		ContainerRuntime:  cr,
 	}
-	return node.Add(cc, n, true, m.commandOptions)
+	return wrapError(reason.GuestNodeAdd, node.Add(cc, n, true, m.commandOptions))
+}
+
+// StartNode (re)starts an existing node of the cluster, applying the cluster configuration to it
+func (m *MinikubeCluster) StartNode(ctx context.Context, cc *config.ClusterConfig, n config.Node) (err error) {
+	defer recoverError(reason.GuestNodeStart, &err)
+
+	if err = ctx.Err(); err != nil {
+		return err
+	}
+
+	return wrapError(reason.GuestNodeStart, node.Add(cc, n, false, m.commandOptions))
+}
+
+// DeleteNode drains the node, deletes its machine and removes it from the cluster config
+func (m *MinikubeCluster) DeleteNode(ctx context.Context, cc *config.ClusterConfig, name string) (err error) {
+	defer recoverError(reason.GuestNodeDelete, &err)
+
+	if err = ctx.Err(); err != nil {
+		return err
+	}
+
+	_, err = node.Delete(*cc, name)
+	return wrapError(reason.GuestNodeDelete, err)
 }
 
-func (m *MinikubeCluster) Delete(cc *config.ClusterConfig, name string) (*config.Node, error) {
+func (m *MinikubeCluster) Delete(ctx context.Context, cc *config.ClusterConfig, name string) (_ *config.Node, err error) {
+	defer recoverError(reason.GuestDeletion, &err)
+
+	if err = ctx.Err(); err != nil {
+		return nil, err
+	}
+
 	errs := delete.DeleteProfiles([]*config.Profile{
 		{
 			Name:   name,
@@ -110,34 +188,345 @@ func (m *MinikubeCluster) Delete(cc *config.ClusterConfig, name string) (*config
 		},
 	}, nil)
 	if len(errs) > 0 {
-		return nil, errs[0]
+		return nil, wrapError(reason.GuestDeletion, errs[0])
+	}
+
+	for _, dir := range clusterDirs(cc, name) {
+		err = rmdir(dir)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return nil, err
+}
+
+// Leftovers lists the machine and profile directories of the cluster that still exist on disk
+func (m *MinikubeCluster) Leftovers(cc *config.ClusterConfig, name string) []string {
+	var leftovers []string
+	for _, dir := range clusterDirs(cc, name) {
+		if _, err := os.Stat(dir); err == nil {
+			leftovers = append(leftovers, dir)
+		}
+	}
+
+	return leftovers
+}
+
+func (m *MinikubeCluster) SetAddon(ctx context.Context, name string, addon string, value string) (err error) {
+	defer recoverError(reason.InternalAddonEnable, &err)
+
+	if err = ctx.Err(); err != nil {
+		return err
+	}
+
+	return wrapError(reason.InternalAddonEnable, minikubeAddons.SetAndSave(name, addon, value, nil))
+}
+
+// EnableAddon applies the addon specific options before enabling the addon, mirroring `minikube addons configure`
+// followed by `minikube addons enable --images --registries`
+func (m *MinikubeCluster) EnableAddon(ctx context.Context, name string, addon string, options AddonOptions) (err error) {
+	defer recoverError(reason.InternalAddonEnable, &err)
+
+	if err = ctx.Err(); err != nil {
+		return err
+	}
+
+	if options.MetalLB != nil {
+		if addon != MetalLB {
+			return fmt.Errorf("metallb options cannot be applied to the %s addon", addon)
+		}
+
+		cc, err := config.Load(name)
+		if err != nil {
+			return err
+		}
+
+		cc.KubernetesConfig.LoadBalancerStartIP = options.MetalLB.StartIP
+		cc.KubernetesConfig.LoadBalancerEndIP = options.MetalLB.EndIP
+		err = config.SaveProfile(name, cc)
+		if err != nil {
+			return err
+		}
+	}
+
+	if options.RegistryCreds != nil {
+		if addon != RegistryCreds {
+			return fmt.Errorf("registry-creds options cannot be applied to the %s addon", addon)
+		}
+
+		err := createRegistryCredsSecrets(name, options.RegistryCreds)
+		if err != nil {
+			return fmt.Errorf("could not create the registry-creds secrets: %w", err)
+		}
+	}
+
+	viper.Set(config.AddonImages, joinAddonOverrides(options.Images))
+	viper.Set(config.AddonRegistries, joinAddonOverrides(options.Registries))
+	defer func() {
+		viper.Set(config.AddonImages, "")
+		viper.Set(config.AddonRegistries, "")
+	}()
+
+	return wrapError(reason.InternalAddonEnable, minikubeAddons.SetAndSave(name, addon, "true", nil))
+}
+
+// Get retrieves the config of an existing cluster, returning nil if it cannot be loaded
+func (m *MinikubeCluster) Get(name string) *config.ClusterConfig {
+	cc, err := m.Load(name)
+	if err != nil {
+		klog.Warningf("unable to load the config of cluster %s: %v", name, err)
+		return nil
+	}
+
+	return cc
+}
+
+// Load retrieves the config of an existing cluster. Unlike mustload, a missing profile is reported
+// as ErrClusterNotFound rather than exiting the process
+func (m *MinikubeCluster) Load(name string) (*config.ClusterConfig, error) {
+	cc, err := config.Load(name)
+	if err != nil {
+		if config.IsNotExist(err) {
+			return nil, fmt.Errorf("%w: %s", ErrClusterNotFound, name)
+		}
+		return nil, err
+	}
+
+	return cc, nil
+}
+
+// Status retrieves the machine and kubernetes state of every node of the cluster
+func (m *MinikubeCluster) Status(ctx context.Context, cc *config.ClusterConfig) (_ []*cluster.Status, err error) {
+	defer recoverError(reason.GuestStatus, &err)
+
+	if err = ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	api, err := machine.NewAPIClient()
+	if err != nil {
+		return nil, wrapError(reason.NewAPIClient, err)
+	}
+	defer api.Close()
+
+	statuses, err := cluster.GetStatus(api, cc)
+	if err != nil {
+		return nil, wrapError(reason.GuestStatus, err)
+	}
+
+	return statuses, nil
+}
+
+// NodeIPs looks up the IP address of each node from its driver, keyed by node name.
+// Nodes whose driver cannot report an address, e.g. because the machine is stopped, are left out
+func (m *MinikubeCluster) NodeIPs(ctx context.Context, cc *config.ClusterConfig) (_ map[string]string, err error) {
+	defer recoverError(reason.GuestStatus, &err)
+
+	api, err := machine.NewAPIClient()
+	if err != nil {
+		return nil, wrapError(reason.NewAPIClient, err)
+	}
+	defer api.Close()
+
+	ips := make(map[string]string, len(cc.Nodes))
+	for _, n := range cc.Nodes {
+		if err = ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		machineName := config.MachineName(*cc, n)
+		h, err := machine.LoadHost(api, machineName)
+		if err != nil {
+			klog.Warningf("unable to load machine %s: %v", machineName, err)
+			continue
+		}
+
+		ip, err := h.Driver.GetIP()
+		if err != nil {
+			klog.Warningf("unable to retrieve the IP of machine %s: %v", machineName, err)
+			continue
+		}
+
+		ips[n.Name] = ip
+	}
+
+	return ips, nil
+}
+
+// Stop stops the machine of every node while keeping its disk, mirroring `minikube stop`
+func (m *MinikubeCluster) Stop(ctx context.Context, cc *config.ClusterConfig) (err error) {
+	defer recoverError(reason.GuestStopTimeout, &err)
+
+	api, err := machine.NewAPIClient()
+	if err != nil {
+		return wrapError(reason.NewAPIClient, err)
+	}
+	defer api.Close()
+
+	for _, n := range cc.Nodes {
+		if err = ctx.Err(); err != nil {
+			return err
+		}
+
+		err = machine.StopHost(api, config.MachineName(*cc, n))
+		if err != nil {
+			return wrapError(reason.GuestStopTimeout, err)
+		}
+	}
+
+	return nil
+}
+
+// Pause freezes the kubernetes containers of every node, mirroring `minikube pause --all-namespaces`
+func (m *MinikubeCluster) Pause(ctx context.Context, cc *config.ClusterConfig) (err error) {
+	defer recoverError(reason.GuestPause, &err)
+
+	return wrapError(reason.GuestPause, m.setPaused(ctx, cc, true))
+}
+
+// Unpause resumes the kubernetes containers of every node, mirroring `minikube unpause --all-namespaces`
+func (m *MinikubeCluster) Unpause(ctx context.Context, cc *config.ClusterConfig) (err error) {
+	defer recoverError(reason.GuestUnpause, &err)
+
+	return wrapError(reason.GuestUnpause, m.setPaused(ctx, cc, false))
+}
+
+func (m *MinikubeCluster) setPaused(ctx context.Context, cc *config.ClusterConfig, paused bool) error {
+	api, err := machine.NewAPIClient()
+	if err != nil {
+		return err
+	}
+	defer api.Close()
+
+	for _, n := range cc.Nodes {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		h, err := machine.LoadHost(api, config.MachineName(*cc, n))
+		if err != nil {
+			return err
+		}
+
+		r, err := machine.CommandRunner(h)
+		if err != nil {
+			return err
+		}
+
+		cr, err := cruntime.New(cruntime.Config{Type: cc.KubernetesConfig.ContainerRuntime, Runner: r})
+		if err != nil {
+			return err
+		}
+
+		// A nil namespace list covers every namespace
+		if paused {
+			_, err = cluster.Pause(cr, r, nil)
+		} else {
+			_, err = cluster.Unpause(cr, r, nil)
+		}
+		if err != nil {
+			return err
+		}
 	}
 
-	machineDir := filepath.Join(localpath.MiniPath(), "machines", name)
-	profilesDir := filepath.Join(localpath.MiniPath(), "profiles", name)
-	err := rmdir(machineDir)
+	return nil
+}
+
+// Kubeconfig retrieves the connection details of an existing cluster from its profile and the kubeconfig minikube wrote at path
+func (m *MinikubeCluster) Kubeconfig(name string, path string) (*kubeconfig.Settings, error) {
+	host, port, err := kubeconfig.Endpoint(name, path)
 	if err != nil {
 		return nil, err
 	}
 
-	err = rmdir(profilesDir)
+	return &kubeconfig.Settings{
+		ClusterName:          name,
+		ClusterServerAddress: fmt.Sprintf("https://%s", net.JoinHostPort(host, strconv.Itoa(port))),
+		ClientCertificate:    localpath.ClientCert(name),
+		ClientKey:            localpath.ClientKey(name),
+		CertificateAuthority: localpath.CACert(),
+	}, nil
+}
+
+// ListProfiles summarises every profile found under MINIKUBE_HOME, including invalid ones left behind by failed runs
+func (m *MinikubeCluster) ListProfiles() ([]Profile, error) {
+	profiles := make([]Profile, 0)
+	if _, err := os.Stat(localpath.MakeMiniPath("profiles")); os.IsNotExist(err) {
+		return profiles, nil
+	}
+
+	validProfiles, invalidProfiles, err := config.ListProfiles()
 	if err != nil {
 		return nil, err
 	}
 
-	return nil, err
+	api, err := machine.NewAPIClient()
+	if err != nil {
+		return nil, err
+	}
+	defer api.Close()
+
+	for _, p := range validProfiles {
+		statuses, err := cluster.GetStatus(api, p.Config)
+		if err != nil {
+			klog.Warningf("unable to retrieve the status of profile %s: %v", p.Name, err)
+		}
+
+		profiles = append(profiles, Profile{
+			Name:              p.Name,
+			Driver:            p.Config.Driver,
+			Status:            SummariseStatus(statuses),
+			Nodes:             len(p.Config.Nodes),
+			KubernetesVersion: p.Config.KubernetesConfig.KubernetesVersion,
+		})
+	}
+
+	for _, p := range invalidProfiles {
+		profile := Profile{
+			Name:   p.Name,
+			Status: StateInvalid,
+		}
+		if p.Config != nil {
+			profile.Driver = p.Config.Driver
+			profile.Nodes = len(p.Config.Nodes)
+			profile.KubernetesVersion = p.Config.KubernetesConfig.KubernetesVersion
+		}
+		profiles = append(profiles, profile)
+	}
+
+	return profiles, nil
 }
 
-func (m *MinikubeCluster) SetAddon(name string, addon string, value string) error {
-	return minikubeAddons.SetAndSave(name, addon, value, nil)
+// nextNodeName derives the name of the next node from the persisted node pool, so that names stay unique across runs
+func nextNodeName(cc *config.ClusterConfig) string {
+	highest := 1 // the primary control plane is always the first node
+	for _, n := range cc.Nodes {
+		if i := nodeIndex(n.Name); i > highest {
+			highest = i
+		}
+	}
+
+	return node.Name(highest + 1)
 }
 
-func (m *MinikubeCluster) Get(name string) *config.ClusterConfig {
-	_, config := mustload.Partial(name, nil)
-	return config
+// nodeIndex returns the index of a node from its name, e.g. m03 is 3. Indexes start from 1 with the unnamed primary control plane
+// https://github.com/kubernetes/minikube/blob/075c1b01f2f8778ac746e05098044234a3f0b06f/pkg/minikube/driver/driver.go#L387C4-L387C27
+func nodeIndex(name string) int {
+	if name == "" {
+		return 1
+	}
+
+	i, err := strconv.Atoi(strings.TrimPrefix(name, "m"))
+	if err != nil {
+		return 0
+	}
+
+	return i
 }
 
-func makeAllMinikubeDirectories() {
+// makeAllMinikubeDirectories mirrors minikube's own setup, reporting failures instead of exiting like exit.Error
+func makeAllMinikubeDirectories() error {
 	dirs := [...]string{
 		localpath.MakeMiniPath("certs"),
 		localpath.MakeMiniPath("machines"),
@@ -149,9 +538,32 @@ func makeAllMinikubeDirectories() {
 	}
 	for _, path := range dirs {
 		if err := os.MkdirAll(path, 0777); err != nil {
-			exit.Error(reason.HostHomeMkdir, "Error creating minikube directory", err)
+			return wrapError(reason.HostHomeMkdir, fmt.Errorf("error creating minikube directory: %w", err))
+		}
+	}
+
+	return nil
+}
+
+// clusterDirs returns the profile directory of the cluster along with the machine directory of each of its nodes
+func clusterDirs(cc *config.ClusterConfig, name string) []string {
+	dirs := []string{
+		filepath.Join(localpath.MiniPath(), "profiles", name),
+		filepath.Join(localpath.MiniPath(), "machines", name),
+	}
+
+	if cc == nil {
+		return dirs
+	}
+
+	for _, n := range cc.Nodes {
+		machineName := config.MachineName(*cc, n)
+		if machineName != name {
+			dirs = append(dirs, filepath.Join(localpath.MiniPath(), "machines", machineName))
 		}
 	}
+
+	return dirs
 }
 
 func rmdir(dir string) error {
@@ -1,6 +1,13 @@
 package lib
 
-import "testing"
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"k8s.io/minikube/pkg/minikube/config"
+)
 
 func TestNewMinikubeClusterInitializesCommandOptions(t *testing.T) {
 	cluster := NewMinikubeCluster()
@@ -9,3 +16,60 @@ func TestNewMinikubeClusterInitializesCommandOptions(t *testing.T) {
 		t.Fatal("NewMinikubeCluster() commandOptions is nil")
 	}
 }
+
+func TestNextNodeName(t *testing.T) {
+	tests := []struct {
+		name  string
+		nodes []config.Node
+		want  string
+	}{
+		{
+			name:  "Second Node",
+			nodes: []config.Node{{Name: ""}},
+			want:  "m02",
+		},
+		{
+			name:  "Skips Gaps Left By Deleted Nodes",
+			nodes: []config.Node{{Name: ""}, {Name: "m02"}, {Name: "m04"}},
+			want:  "m05",
+		},
+		{
+			name:  "Ignores Node Order",
+			nodes: []config.Node{{Name: "m03"}, {Name: ""}, {Name: "m02"}},
+			want:  "m04",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := nextNodeName(&config.ClusterConfig{Nodes: tt.nodes}); got != tt.want {
+				t.Errorf("nextNodeName() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLeftovers(t *testing.T) {
+	home := filepath.Join(t.TempDir(), ".minikube")
+	t.Setenv("MINIKUBE_HOME", home)
+
+	cc := &config.ClusterConfig{
+		Name:  "cluster",
+		Nodes: []config.Node{{Name: "", ControlPlane: true}, {Name: "m02"}},
+	}
+
+	cluster := NewMinikubeCluster()
+	if got := cluster.Leftovers(cc, "cluster"); len(got) != 0 {
+		t.Errorf("Leftovers() = %v, want nothing before the cluster exists", got)
+	}
+
+	for _, dir := range []string{"profiles/cluster", "machines/cluster-m02", "machines/other"} {
+		if err := os.MkdirAll(filepath.Join(home, dir), 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	want := []string{filepath.Join(home, "profiles", "cluster"), filepath.Join(home, "machines", "cluster-m02")}
+	if got := cluster.Leftovers(cc, "cluster"); !reflect.DeepEqual(got, want) {
+		t.Errorf("Leftovers() = %v, want %v", got, want)
+	}
+}
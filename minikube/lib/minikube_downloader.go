@@ -1,11 +1,22 @@
 //go:generate go run github.com/golang/mock/mockgen -source=$GOFILE -destination=mock_$GOFILE -package=$GOPACKAGE
 package lib
 
-import "k8s.io/minikube/pkg/minikube/download"
+import (
+	"context"
+	"net/url"
+	"path"
+	"path/filepath"
+
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/detect"
+	"k8s.io/minikube/pkg/minikube/download"
+	"k8s.io/minikube/pkg/minikube/localpath"
+)
 
 type Downloader interface {
-	ISO(urls []string, skipChecksum bool) (string, error)
-	PreloadTarball(k8sVersion, containerRuntime, driver string) error
+	ISO(ctx context.Context, urls []string, skipChecksum bool) (string, error)
+	PreloadTarball(ctx context.Context, k8sVersion, containerRuntime, driver string) error
+	Purge(ctx context.Context, cc *config.ClusterConfig) error
 }
 
 type MinikubeDownloader struct {
@@ -15,10 +26,79 @@ func NewMinikubeDownloader() *MinikubeDownloader {
 	return &MinikubeDownloader{}
 }
 
-func (m *MinikubeDownloader) ISO(urls []string, skipChecksum bool) (string, error) {
-	return download.ISO(urls, skipChecksum)
+func (m *MinikubeDownloader) ISO(ctx context.Context, urls []string, skipChecksum bool) (string, error) {
+	var iso string
+	err := runWithContext(ctx, func() (err error) {
+		iso, err = download.ISO(urls, skipChecksum)
+		return err
+	})
+
+	return iso, err
+}
+
+func (m *MinikubeDownloader) PreloadTarball(ctx context.Context, k8sVersion, containerRuntime, driver string) error {
+	return runWithContext(ctx, func() error {
+		return download.Preload(k8sVersion, containerRuntime, driver)
+	})
+}
+
+// Purge removes the cached ISO, preload tarball and kic base image used by the cluster from MINIKUBE_HOME.
+// Other clusters on the same versions download them again when needed
+func (m *MinikubeDownloader) Purge(ctx context.Context, cc *config.ClusterConfig) error {
+	files := []string{
+		download.TarballPath(cc.KubernetesConfig.KubernetesVersion, cc.KubernetesConfig.ContainerRuntime),
+	}
+
+	if cc.MinikubeISO != "" {
+		u, err := url.Parse(cc.MinikubeISO)
+		if err == nil && u.Scheme != "file" {
+			files = append(files, filepath.Join(detect.ISOCacheDir(), path.Base(u.Path)))
+		}
+	}
+
+	if cc.KicBaseImage != "" {
+		files = append(files, localpath.SanitizeCacheDir(filepath.Join(detect.KICCacheDir(), path.Base(cc.KicBaseImage)+".tar")))
+	}
+
+	for _, f := range files {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		err := rmdir(f)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
-func (m *MinikubeDownloader) PreloadTarball(k8sVersion, containerRuntime, driver string) error {
-	return download.Preload(k8sVersion, containerRuntime, driver)
+// runWithContext runs a download, returning as soon as ctx is done. minikube's downloads cannot be interrupted,
+// so an abandoned download carries on in the background and completes the cache entry for a later run.
+// The download holds the cache lock shared until it finishes, so that a purge waits for it even once the caller
+// has moved on. It must only be used for work that touches nothing but the cache
+func runWithContext(ctx context.Context, download func() error) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	// The caller holds the cache lock already, so this never has to wait
+	cache, err := acquireLock(ctx, cacheLockPath(), false, 0, "download")
+	if err != nil {
+		return err
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		defer cache.Unlock()
+		done <- download()
+	}()
+
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
 }
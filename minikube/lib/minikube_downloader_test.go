@@ -0,0 +1,47 @@
+package lib
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestRunWithContext(t *testing.T) {
+	err := runWithContext(context.Background(), func() error {
+		return errors.New("download error")
+	})
+	if err == nil || err.Error() != "download error" {
+		t.Errorf("runWithContext() error = %v, want the download error", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	release := make(chan struct{})
+	finished := make(chan struct{})
+
+	go cancel()
+	err = runWithContext(ctx, func() error {
+		<-release
+		close(finished)
+		return nil
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("runWithContext() error = %v, want %v for an abandoned download", err, context.Canceled)
+	}
+
+	// A purge has to wait for the abandoned download, which is still writing to the cache
+	_, err = acquireLock(context.Background(), cacheLockPath(), true, 0, "purge")
+	var lockErr *LockTimeoutError
+	if !errors.As(err, &lockErr) {
+		t.Errorf("acquireLock() error = %v, want the cache to stay locked by the abandoned download", err)
+	}
+
+	close(release)
+	<-finished
+
+	cache, err := acquireLock(context.Background(), cacheLockPath(), true, 5*time.Second, "purge")
+	if err != nil {
+		t.Fatalf("acquireLock() error = %v, want the cache released once the download finished", err)
+	}
+	cache.Unlock()
+}
@@ -0,0 +1,73 @@
+package lib
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime"
+	"runtime/debug"
+	"strings"
+
+	"k8s.io/minikube/pkg/minikube/reason"
+)
+
+// MinikubeError is an error raised by minikube, along with the reason minikube would have exited with
+type MinikubeError struct {
+	Reason reason.Kind
+	Err    error
+}
+
+func (e *MinikubeError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *MinikubeError) Unwrap() error {
+	return e.Err
+}
+
+// Detail describes the reason behind the error and the advice minikube gives for it
+func (e *MinikubeError) Detail() string {
+	detail := []string{fmt.Sprintf("Reason: %s", e.Reason.ID)}
+	if e.Reason.Advice != "" {
+		detail = append(detail, fmt.Sprintf("Advice: %s", e.Reason.Advice))
+	}
+	if e.Reason.URL != "" {
+		detail = append(detail, fmt.Sprintf("Documentation: %s", e.Reason.URL))
+	}
+	for _, issue := range e.Reason.Issues {
+		detail = append(detail, fmt.Sprintf("Related issue: https://github.com/kubernetes/minikube/issues/%d", issue))
+	}
+
+	return strings.Join(detail, "\n")
+}
+
+// wrapError attaches a reason to an error returned by minikube, preferring a known issue that matches the error
+// over the generic reason of the failing step. Cancellations and timeouts are not minikube's doing, so they are left as is
+func wrapError(kind reason.Kind, err error) error {
+	if err == nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return err
+	}
+
+	var minikubeErr *MinikubeError
+	if errors.As(err, &minikubeErr) {
+		return err
+	}
+
+	if known := reason.MatchKnownIssue(kind, err, runtime.GOOS); known != nil {
+		kind = *known
+	}
+
+	return &MinikubeError{Reason: kind, Err: err}
+}
+
+// recoverError converts a panic raised within minikube into an error, rather than letting it crash the provider.
+// Calls to os.Exit, e.g. through exit.Error or mustload, cannot be recovered from, so the operations making them run
+// in an isolated helper, see GuardedClient. It must be deferred directly by a function with a named error result
+func recoverError(kind reason.Kind, err *error) {
+	if r := recover(); r != nil {
+		*err = &MinikubeError{
+			Reason: kind,
+			Err:    fmt.Errorf("minikube panicked: %v\n%s", r, debug.Stack()),
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package lib
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"k8s.io/minikube/pkg/minikube/reason"
+)
+
+func TestWrapError(t *testing.T) {
+	if err := wrapError(reason.GuestStart, nil); err != nil {
+		t.Errorf("wrapError() = %v, want nil", err)
+	}
+
+	cause := errors.New("something went wrong")
+	err := wrapError(reason.GuestStart, cause)
+
+	var minikubeErr *MinikubeError
+	if !errors.As(err, &minikubeErr) {
+		t.Fatalf("wrapError() = %T, want *MinikubeError", err)
+	}
+	if !errors.Is(err, cause) {
+		t.Errorf("wrapError() does not wrap %v", cause)
+	}
+	if minikubeErr.Reason.ID != reason.GuestStart.ID {
+		t.Errorf("wrapError() reason = %v, want %v", minikubeErr.Reason.ID, reason.GuestStart.ID)
+	}
+
+	// Errors that already carry a reason keep it
+	if rewrapped := wrapError(reason.GuestProvision, err); rewrapped != err {
+		t.Errorf("wrapError() = %v, want %v", rewrapped, err)
+	}
+
+	// Cancellations are not attributed to minikube
+	if err := wrapError(reason.GuestStart, context.Canceled); err != context.Canceled {
+		t.Errorf("wrapError() = %v, want %v", err, context.Canceled)
+	}
+}
+
+func TestRecoverError(t *testing.T) {
+	panics := func() (err error) {
+		defer recoverError(reason.GuestNodeAdd, &err)
+		panic("boom")
+	}
+
+	err := panics()
+
+	var minikubeErr *MinikubeError
+	if !errors.As(err, &minikubeErr) {
+		t.Fatalf("recoverError() = %T, want *MinikubeError", err)
+	}
+	if minikubeErr.Reason.ID != reason.GuestNodeAdd.ID {
+		t.Errorf("recoverError() reason = %v, want %v", minikubeErr.Reason.ID, reason.GuestNodeAdd.ID)
+	}
+	if !strings.Contains(err.Error(), "boom") {
+		t.Errorf("recoverError() = %v, want it to contain the panic value", err)
+	}
+	if !strings.Contains(minikubeErr.Detail(), reason.GuestNodeAdd.ID) {
+		t.Errorf("Detail() = %v, want it to contain the reason", minikubeErr.Detail())
+	}
+}
@@ -0,0 +1,75 @@
+package lib
+
+import (
+	"context"
+
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/kubeconfig"
+)
+
+// GuardedClient is the ClusterClient of the in_process execution mode. Operations run in the provider process,
+// except for those provisioning machines: minikube exits the process on their fatal errors, e.g. through exit.Error,
+// which would take the provider down with it. Start, Restart, ScaleNodes and AddNode therefore run in an isolated
+// helper, whose exit is reported as a MinikubeError
+type GuardedClient struct {
+	*MinikubeClient
+
+	isolated *IsolatedClient
+}
+
+// NewGuardedClient wraps an in-process client, running its provisioning operations in helpers of the current executable
+func NewGuardedClient(client *MinikubeClient) (*GuardedClient, error) {
+	isolated, err := NewIsolatedClient(client.K8sVersion, client.DefaultKubeconfigPath)
+	if err != nil {
+		return nil, err
+	}
+	isolated.LockTimeout = client.LockTimeout
+
+	return &GuardedClient{MinikubeClient: client, isolated: isolated}, nil
+}
+
+func (c *GuardedClient) SetConfig(args MinikubeClientConfig) {
+	c.MinikubeClient.SetConfig(args)
+	c.isolated.SetConfig(args)
+}
+
+func (c *GuardedClient) Start(ctx context.Context) (kc *kubeconfig.Settings, err error) {
+	err = c.guard(func() error {
+		kc, err = c.isolated.Start(ctx)
+		return err
+	})
+	return kc, err
+}
+
+func (c *GuardedClient) Restart(ctx context.Context) (kc *kubeconfig.Settings, err error) {
+	err = c.guard(func() error {
+		kc, err = c.isolated.Restart(ctx)
+		return err
+	})
+	return kc, err
+}
+
+func (c *GuardedClient) ScaleNodes(ctx context.Context, nodes int) error {
+	return c.guard(func() error {
+		return c.isolated.ScaleNodes(ctx, nodes)
+	})
+}
+
+func (c *GuardedClient) AddNode(ctx context.Context, options NodeOptions) (n *config.Node, err error) {
+	err = c.guard(func() error {
+		n, err = c.isolated.AddNode(ctx, options)
+		return err
+	})
+	return n, err
+}
+
+// guard runs an operation in the helper, holding TfCreationLock like the operations run in process, and takes over
+// the client config the helper reports back
+func (c *GuardedClient) guard(op func() error) error {
+	defer c.lock()()
+
+	err := op()
+	c.MinikubeClient.SetConfig(c.isolated.GetConfig())
+
+	return err
+}
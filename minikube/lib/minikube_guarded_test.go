@@ -0,0 +1,44 @@
+package lib
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+func TestGuardedClient(t *testing.T) {
+	t.Setenv("MINIKUBE_HOME", filepath.Join(t.TempDir(), ".minikube"))
+
+	executable, err := os.Executable()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Operations that provision machines never reach the in-process dependencies
+	client := &GuardedClient{
+		MinikubeClient: &MinikubeClient{
+			TfCreationLock: &sync.RWMutex{},
+			nRunner:        NewMockCluster(gomock.NewController(t)),
+		},
+		isolated: &IsolatedClient{executable: executable},
+	}
+	client.SetConfig(MinikubeClientConfig{ClusterName: "cluster", Nodes: 1})
+
+	err = client.ScaleNodes(context.Background(), 2)
+	if err == nil {
+		t.Errorf("GuardedClient.ScaleNodes() expected an error for a cluster that does not exist")
+	}
+
+	_, err = client.AddNode(context.Background(), NodeOptions{Role: RoleWorker})
+	if err == nil {
+		t.Errorf("GuardedClient.AddNode() expected an error for a cluster that does not exist")
+	}
+
+	if got := client.GetConfig(); got.ClusterName != "cluster" || got.Nodes != 1 {
+		t.Errorf("GuardedClient.GetConfig() = %+v, want the config reported back by the helper", got)
+	}
+}
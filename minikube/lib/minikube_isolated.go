@@ -0,0 +1,567 @@
+package lib
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"os/exec"
+	"os/signal"
+	"path"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"k8s.io/klog/v2"
+	"k8s.io/minikube/pkg/minikube/cluster"
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/kubeconfig"
+	"k8s.io/minikube/pkg/minikube/out"
+	"k8s.io/minikube/pkg/minikube/reason"
+)
+
+const (
+	ExecutionModeInProcess  = "in_process"
+	ExecutionModeSubprocess = "subprocess"
+
+	// IsolatedHelperEnv marks a re-exec'd provider binary as a helper that runs a single cluster operation
+	IsolatedHelperEnv = "TF_MINIKUBE_ISOLATED_HELPER"
+
+	// isolatedResponsePrefix marks the line carrying the response, as minikube also writes to stdout
+	isolatedResponsePrefix = "@minikube-isolated-response "
+
+	// minikubeErrorEvent is the type of the event minikube writes in JSON mode before exiting on a fatal error
+	minikubeErrorEvent = "io.k8s.sigs.minikube.error"
+)
+
+// isolatedShutdownTimeout bounds how long an interrupted helper may take to finish its current step and clean up
+var isolatedShutdownTimeout = 5 * time.Minute
+
+// IsolatedClient is a ClusterClient that runs every operation in a helper subprocess of the provider binary.
+// Each helper has its own viper and minikube globals, so operations on different clusters can run concurrently.
+// Where minikube exits the process on a fatal error, only the helper exits, and its reason is returned as a MinikubeError
+type IsolatedClient struct {
+	config MinikubeClientConfig
+
+	K8sVersion string
+
+	// DefaultKubeconfigPath is the kubeconfig written to by clusters that do not set their own path
+	DefaultKubeconfigPath string
+
+	// LockTimeout bounds how long operations wait for another terraform run holding the lock of the profile or the download cache
+	LockTimeout time.Duration
+
+	// executable is the provider binary re-exec'd as the helper
+	executable string
+}
+
+// isolatedArgs holds the arguments of every operation, each of which only sets its own
+type isolatedArgs struct {
+	Nodes        int
+	Name         string
+	NodeOptions  NodeOptions
+	Addons       []string
+	AddonOptions AddonOptions
+	Cluster      *config.ClusterConfig
+}
+
+type isolatedRequest struct {
+	Method                string
+	Config                MinikubeClientConfig
+	K8sVersion            string
+	DefaultKubeconfigPath string
+	LockTimeout           time.Duration
+	Args                  isolatedArgs
+}
+
+type isolatedResponse struct {
+	Config MinikubeClientConfig
+	Result json.RawMessage `json:",omitempty"`
+	Error  *isolatedError  `json:",omitempty"`
+}
+
+// isolatedError carries an error across the process boundary, keeping what callers match on
+type isolatedError struct {
+	Message          string
+	Reason           *reason.Kind      `json:",omitempty"`
+	NotFound         bool              `json:",omitempty"`
+	Canceled         bool              `json:",omitempty"`
+	DeadlineExceeded bool              `json:",omitempty"`
+	LockTimeout      *LockTimeoutError `json:",omitempty"`
+}
+
+// isolatedExit is the fatal error minikube reported before exiting a helper, e.g. through exit.Error or mustload
+type isolatedExit struct {
+	Type string                 `json:"type"`
+	Data map[string]interface{} `json:"data"`
+}
+
+// remoteError is an error raised by a helper, unwrapping to the sentinel it was raised with
+type remoteError struct {
+	message string
+	cause   error
+}
+
+func (e *remoteError) Error() string {
+	return e.message
+}
+
+func (e *remoteError) Unwrap() error {
+	return e.cause
+}
+
+// NewIsolatedClient creates a client running its operations in helpers of the current executable
+func NewIsolatedClient(k8sVersion string, defaultKubeconfigPath string) (*IsolatedClient, error) {
+	executable, err := os.Executable()
+	if err != nil {
+		return nil, fmt.Errorf("could not locate the provider binary to run isolated helpers: %w", err)
+	}
+
+	return &IsolatedClient{
+		K8sVersion:            k8sVersion,
+		DefaultKubeconfigPath: defaultKubeconfigPath,
+		executable:            executable,
+	}, nil
+}
+
+func (c *IsolatedClient) SetConfig(args MinikubeClientConfig) {
+	c.config = args
+}
+
+func (c *IsolatedClient) GetConfig() MinikubeClientConfig {
+	return c.config
+}
+
+// SetDependencies is a no-op, as every helper creates its own dependencies
+func (c *IsolatedClient) SetDependencies(dep MinikubeClientDeps) {
+}
+
+func (c *IsolatedClient) Start(ctx context.Context) (kc *kubeconfig.Settings, err error) {
+	err = c.call(ctx, "Start", isolatedArgs{}, &kc)
+	return kc, err
+}
+
+func (c *IsolatedClient) Restart(ctx context.Context) (kc *kubeconfig.Settings, err error) {
+	err = c.call(ctx, "Restart", isolatedArgs{}, &kc)
+	return kc, err
+}
+
+func (c *IsolatedClient) ScaleNodes(ctx context.Context, nodes int) error {
+	return c.call(ctx, "ScaleNodes", isolatedArgs{Nodes: nodes}, nil)
+}
+
+func (c *IsolatedClient) Resize(ctx context.Context) error {
+	return c.call(ctx, "Resize", isolatedArgs{}, nil)
+}
+
+func (c *IsolatedClient) AddNode(ctx context.Context, options NodeOptions) (n *config.Node, err error) {
+	err = c.call(ctx, "AddNode", isolatedArgs{NodeOptions: options}, &n)
+	return n, err
+}
+
+func (c *IsolatedClient) RemoveNode(ctx context.Context, name string) error {
+	return c.call(ctx, "RemoveNode", isolatedArgs{Name: name}, nil)
+}
+
+func (c *IsolatedClient) AttachNode(ctx context.Context, name string) error {
+	return c.call(ctx, "AttachNode", isolatedArgs{Name: name}, nil)
+}
+
+func (c *IsolatedClient) GetAttachedNodes() (names []string, err error) {
+	err = c.call(context.Background(), "GetAttachedNodes", isolatedArgs{}, &names)
+	return names, err
+}
+
+func (c *IsolatedClient) Stop(ctx context.Context) error {
+	return c.call(ctx, "Stop", isolatedArgs{}, nil)
+}
+
+func (c *IsolatedClient) Pause(ctx context.Context) error {
+	return c.call(ctx, "Pause", isolatedArgs{}, nil)
+}
+
+func (c *IsolatedClient) Unpause(ctx context.Context) error {
+	return c.call(ctx, "Unpause", isolatedArgs{}, nil)
+}
+
+func (c *IsolatedClient) Delete(ctx context.Context) error {
+	return c.call(ctx, "Delete", isolatedArgs{}, nil)
+}
+
+func (c *IsolatedClient) Purge(ctx context.Context) error {
+	return c.call(ctx, "Purge", isolatedArgs{}, nil)
+}
+
+// GetClusterConfig retrieves the latest cluster config from minikube, returning nil if it cannot be loaded
+func (c *IsolatedClient) GetClusterConfig() (cc *config.ClusterConfig) {
+	err := c.call(context.Background(), "GetClusterConfig", isolatedArgs{}, &cc)
+	if err != nil {
+		klog.Warningf("unable to load the config of cluster %s: %v", c.config.ClusterName, err)
+		return nil
+	}
+
+	return cc
+}
+
+func (c *IsolatedClient) LoadClusterConfig() (cc *config.ClusterConfig, err error) {
+	err = c.call(context.Background(), "LoadClusterConfig", isolatedArgs{}, &cc)
+	return cc, err
+}
+
+func (c *IsolatedClient) GetStatus(ctx context.Context, cc *config.ClusterConfig) (statuses []*cluster.Status, err error) {
+	err = c.call(ctx, "GetStatus", isolatedArgs{Cluster: cc}, &statuses)
+	return statuses, err
+}
+
+func (c *IsolatedClient) GetNodeIPs(ctx context.Context, cc *config.ClusterConfig) (ips map[string]string, err error) {
+	err = c.call(ctx, "GetNodeIPs", isolatedArgs{Cluster: cc}, &ips)
+	return ips, err
+}
+
+func (c *IsolatedClient) GetKubeconfig() (kc *kubeconfig.Settings, err error) {
+	err = c.call(context.Background(), "GetKubeconfig", isolatedArgs{}, &kc)
+	return kc, err
+}
+
+// GetKubeconfigPath resolves the kubeconfig the cluster's context is written to, which needs no helper
+func (c *IsolatedClient) GetKubeconfigPath() string {
+	return c.client().GetKubeconfigPath()
+}
+
+func (c *IsolatedClient) ListProfiles() (profiles []Profile, err error) {
+	err = c.call(context.Background(), "ListProfiles", isolatedArgs{}, &profiles)
+	return profiles, err
+}
+
+func (c *IsolatedClient) GetK8sVersion() string {
+	return c.K8sVersion
+}
+
+func (c *IsolatedClient) ApplyAddons(ctx context.Context, addons []string) error {
+	return c.call(ctx, "ApplyAddons", isolatedArgs{Addons: addons}, nil)
+}
+
+func (c *IsolatedClient) EnableAddon(ctx context.Context, addon string, options AddonOptions) error {
+	return c.call(ctx, "EnableAddon", isolatedArgs{Name: addon, AddonOptions: options}, nil)
+}
+
+func (c *IsolatedClient) DisableAddon(ctx context.Context, addon string) error {
+	return c.call(ctx, "DisableAddon", isolatedArgs{Name: addon}, nil)
+}
+
+func (c *IsolatedClient) GetAddons() (addons []string) {
+	err := c.call(context.Background(), "GetAddons", isolatedArgs{}, &addons)
+	if err != nil {
+		klog.Warningf("unable to retrieve the addons of cluster %s: %v", c.config.ClusterName, err)
+		return []string{}
+	}
+
+	return addons
+}
+
+// client builds the in-process client a helper runs the operation with
+func (c *IsolatedClient) client() *MinikubeClient {
+	client := NewMinikubeClient(c.config, MinikubeClientDeps{
+		Node:       NewMinikubeCluster(),
+		Downloader: NewMinikubeDownloader(),
+	})
+	client.K8sVersion = c.K8sVersion
+	client.DefaultKubeconfigPath = c.DefaultKubeconfigPath
+	client.LockTimeout = c.LockTimeout
+
+	return client
+}
+
+// call runs a single operation in a helper and decodes its result. The helper reports back the client config,
+// as operations such as ScaleNodes and ApplyAddons update it. Cancelling ctx interrupts the helper, which
+// stops at its next step and cleans up as the in-process client would
+func (c *IsolatedClient) call(ctx context.Context, method string, args isolatedArgs, result interface{}) error {
+	request, err := json.Marshal(isolatedRequest{
+		Method:                method,
+		Config:                c.config,
+		K8sVersion:            c.K8sVersion,
+		DefaultKubeconfigPath: c.DefaultKubeconfigPath,
+		LockTimeout:           c.LockTimeout,
+		Args:                  args,
+	})
+	if err != nil {
+		return err
+	}
+
+	var stdout bytes.Buffer
+	cmd := exec.CommandContext(ctx, c.executable)
+	cmd.Env = append(os.Environ(), IsolatedHelperEnv+"=1")
+	cmd.Stdin = bytes.NewReader(request)
+	cmd.Stdout = &stdout
+	cmd.Stderr = os.Stderr
+	cmd.Cancel = func() error {
+		// Interrupt signals are not supported on windows
+		if err := cmd.Process.Signal(os.Interrupt); err != nil {
+			return cmd.Process.Kill()
+		}
+		return nil
+	}
+	cmd.WaitDelay = isolatedShutdownTimeout
+
+	runErr := cmd.Run()
+
+	response, exit, err := readIsolatedResponse(&stdout)
+	if err != nil {
+		if exit != nil {
+			return exit.err(method, c.config.ClusterName)
+		}
+		if runErr != nil {
+			return fmt.Errorf("the isolated helper running %s for cluster %s failed: %w", method, c.config.ClusterName, runErr)
+		}
+		return err
+	}
+
+	c.config = response.Config
+	if response.Error != nil {
+		return response.Error.err()
+	}
+
+	if result == nil || len(response.Result) == 0 {
+		return nil
+	}
+
+	return json.Unmarshal(response.Result, result)
+}
+
+// readIsolatedResponse finds the response among the output of a helper, passing anything else on to the log.
+// A helper that minikube exited has no response, but may have reported why it exited
+func readIsolatedResponse(r io.Reader) (*isolatedResponse, *isolatedExit, error) {
+	var response *isolatedResponse
+	var exit *isolatedExit
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 64*1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if !strings.HasPrefix(line, isolatedResponsePrefix) {
+			klog.Info(line)
+
+			event := &isolatedExit{}
+			if json.Unmarshal([]byte(line), event) == nil && event.Type == minikubeErrorEvent {
+				exit = event
+			}
+			continue
+		}
+
+		response = &isolatedResponse{}
+		err := json.Unmarshal([]byte(strings.TrimPrefix(line, isolatedResponsePrefix)), response)
+		if err != nil {
+			return nil, exit, fmt.Errorf("could not decode the response of the isolated helper: %w", err)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, exit, err
+	}
+
+	if response == nil {
+		return nil, exit, errors.New("the isolated helper exited without a response")
+	}
+
+	return response, exit, nil
+}
+
+// err converts the fatal error into a MinikubeError carrying minikube's reason and advice
+func (e *isolatedExit) err(method string, clusterName string) error {
+	field := func(key string) string {
+		if v, ok := e.Data[key]; ok && v != nil {
+			return fmt.Sprint(v)
+		}
+		return ""
+	}
+
+	exitCode, _ := strconv.Atoi(field("exitcode"))
+	kind := reason.Kind{
+		ID:       field("name"),
+		ExitCode: exitCode,
+		Advice:   field("advice"),
+		URL:      field("url"),
+	}
+	for _, issue := range strings.Split(field("issues"), ",") {
+		if n, err := strconv.Atoi(path.Base(issue)); err == nil {
+			kind.Issues = append(kind.Issues, n)
+		}
+	}
+
+	return &MinikubeError{
+		Reason: kind,
+		Err:    fmt.Errorf("minikube exited while running %s for cluster %s: %s", method, clusterName, field("message")),
+	}
+}
+
+// IsIsolatedHelper reports whether the process was started as an isolated helper rather than by terraform
+func IsIsolatedHelper() bool {
+	return os.Getenv(IsolatedHelperEnv) != ""
+}
+
+// ServeIsolatedHelper runs the single operation requested on stdin and writes its response to stdout,
+// returning the exit code of the helper
+func ServeIsolatedHelper() int {
+	// The provider process forwards the helper's stderr to terraform's log
+	klog.SetOutput(os.Stderr)
+	log.SetOutput(os.Stderr)
+
+	// Where minikube exits the process, e.g. through exit.Error, it first writes the reason as an event to stdout.
+	// The provider process turns that event into a diagnostic, instead of reporting a crashed helper
+	out.SetJSON(true)
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	var request isolatedRequest
+	err := json.NewDecoder(os.Stdin).Decode(&request)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "could not decode the request of the isolated helper: %v\n", err)
+		return 1
+	}
+
+	client := (&IsolatedClient{
+		config:                request.Config,
+		K8sVersion:            request.K8sVersion,
+		DefaultKubeconfigPath: request.DefaultKubeconfigPath,
+		LockTimeout:           request.LockTimeout,
+	}).client()
+
+	raw, err := json.Marshal(handleIsolated(ctx, request, client))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "could not encode the response of the isolated helper: %v\n", err)
+		return 1
+	}
+
+	fmt.Fprintf(os.Stdout, "%s%s\n", isolatedResponsePrefix, raw)
+
+	return 0
+}
+
+// handleIsolated runs the requested operation against an in-process client
+func handleIsolated(ctx context.Context, request isolatedRequest, client *MinikubeClient) isolatedResponse {
+	result, err := runIsolated(ctx, request, client)
+
+	response := isolatedResponse{
+		Config: client.GetConfig(),
+		Error:  newIsolatedError(err),
+	}
+
+	if err == nil && result != nil {
+		raw, err := json.Marshal(result)
+		if err != nil {
+			response.Error = newIsolatedError(err)
+		}
+		response.Result = raw
+	}
+
+	return response
+}
+
+func runIsolated(ctx context.Context, request isolatedRequest, client *MinikubeClient) (interface{}, error) {
+	args := request.Args
+
+	switch request.Method {
+	case "Start":
+		return client.Start(ctx)
+	case "Restart":
+		return client.Restart(ctx)
+	case "ScaleNodes":
+		return nil, client.ScaleNodes(ctx, args.Nodes)
+	case "Resize":
+		return nil, client.Resize(ctx)
+	case "AddNode":
+		return client.AddNode(ctx, args.NodeOptions)
+	case "RemoveNode":
+		return nil, client.RemoveNode(ctx, args.Name)
+	case "AttachNode":
+		return nil, client.AttachNode(ctx, args.Name)
+	case "GetAttachedNodes":
+		return client.GetAttachedNodes()
+	case "Stop":
+		return nil, client.Stop(ctx)
+	case "Pause":
+		return nil, client.Pause(ctx)
+	case "Unpause":
+		return nil, client.Unpause(ctx)
+	case "Delete":
+		return nil, client.Delete(ctx)
+	case "Purge":
+		return nil, client.Purge(ctx)
+	case "GetClusterConfig":
+		return client.GetClusterConfig(), nil
+	case "LoadClusterConfig":
+		return client.LoadClusterConfig()
+	case "GetStatus":
+		return client.GetStatus(ctx, args.Cluster)
+	case "GetNodeIPs":
+		return client.GetNodeIPs(ctx, args.Cluster)
+	case "GetKubeconfig":
+		return client.GetKubeconfig()
+	case "ListProfiles":
+		return client.ListProfiles()
+	case "ApplyAddons":
+		return nil, client.ApplyAddons(ctx, args.Addons)
+	case "EnableAddon":
+		return nil, client.EnableAddon(ctx, args.Name, args.AddonOptions)
+	case "DisableAddon":
+		return nil, client.DisableAddon(ctx, args.Name)
+	case "GetAddons":
+		return client.GetAddons(), nil
+	}
+
+	return nil, fmt.Errorf("unknown isolated operation %s", request.Method)
+}
+
+func newIsolatedError(err error) *isolatedError {
+	if err == nil {
+		return nil
+	}
+
+	e := &isolatedError{
+		Message:          err.Error(),
+		NotFound:         errors.Is(err, ErrClusterNotFound),
+		Canceled:         errors.Is(err, context.Canceled),
+		DeadlineExceeded: errors.Is(err, context.DeadlineExceeded),
+	}
+
+	var minikubeErr *MinikubeError
+	if errors.As(err, &minikubeErr) {
+		e.Reason = &minikubeErr.Reason
+	}
+
+	var lockErr *LockTimeoutError
+	if errors.As(err, &lockErr) {
+		e.LockTimeout = lockErr
+	}
+
+	return e
+}
+
+// err rebuilds the error raised by the helper, so that it still matches ErrClusterNotFound, MinikubeError,
+// LockTimeoutError and context errors
+func (e *isolatedError) err() error {
+	var cause error
+	switch {
+	case e.NotFound:
+		cause = ErrClusterNotFound
+	case e.Canceled:
+		cause = context.Canceled
+	case e.DeadlineExceeded:
+		cause = context.DeadlineExceeded
+	case e.LockTimeout != nil:
+		cause = e.LockTimeout
+	}
+
+	var err error = &remoteError{message: e.Message, cause: cause}
+	if e.Reason != nil {
+		err = &MinikubeError{Reason: *e.Reason, Err: err}
+	}
+
+	return err
+}
@@ -0,0 +1,149 @@
+package lib
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	gomock "github.com/golang/mock/gomock"
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/reason"
+)
+
+func TestIsolatedClient(t *testing.T) {
+	t.Setenv("MINIKUBE_HOME", filepath.Join(t.TempDir(), ".minikube"))
+
+	executable, err := os.Executable()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client := &IsolatedClient{executable: executable}
+	client.SetConfig(MinikubeClientConfig{ClusterName: "cluster", Nodes: 1})
+
+	profiles, err := client.ListProfiles()
+	if err != nil {
+		t.Fatalf("IsolatedClient.ListProfiles() error = %v", err)
+	}
+	if len(profiles) != 0 {
+		t.Errorf("IsolatedClient.ListProfiles() = %v, want no profiles", profiles)
+	}
+
+	_, err = client.LoadClusterConfig()
+	if !errors.Is(err, ErrClusterNotFound) {
+		t.Errorf("IsolatedClient.LoadClusterConfig() error = %v, want %v", err, ErrClusterNotFound)
+	}
+
+	if got := client.GetConfig(); got.ClusterName != "cluster" || got.Nodes != 1 {
+		t.Errorf("IsolatedClient.GetConfig() = %+v, want the config it was given", got)
+	}
+}
+
+func TestHandleIsolated(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	nRunner := NewMockCluster(ctrl)
+	nRunner.EXPECT().
+		Get("cluster").
+		Return(&config.ClusterConfig{Nodes: []config.Node{{ControlPlane: true}}})
+	nRunner.EXPECT().
+		Status(gomock.Any(), gomock.Any()).
+		Return(nil, nil)
+	nRunner.EXPECT().
+		AddWorkerNode(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil)
+
+	client := NewMinikubeClient(MinikubeClientConfig{ClusterName: "cluster", Nodes: 1}, MinikubeClientDeps{Node: nRunner})
+
+	response := handleIsolated(context.Background(), isolatedRequest{
+		Method: "ScaleNodes",
+		Args:   isolatedArgs{Nodes: 2},
+	}, client)
+
+	if response.Error != nil {
+		t.Fatalf("handleIsolated() error = %v", response.Error.Message)
+	}
+	if response.Config.Nodes != 2 {
+		t.Errorf("handleIsolated() nodes = %v, want the updated client config", response.Config.Nodes)
+	}
+
+	response = handleIsolated(context.Background(), isolatedRequest{Method: "Unknown"}, client)
+	if response.Error == nil {
+		t.Errorf("handleIsolated() expected an error for an unknown operation")
+	}
+}
+
+func TestIsolatedError(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    error
+		target error
+	}{
+		{name: "Cluster Not Found", err: ErrClusterNotFound, target: ErrClusterNotFound},
+		{name: "Canceled", err: context.Canceled, target: context.Canceled},
+		{name: "Deadline Exceeded", err: context.DeadlineExceeded, target: context.DeadlineExceeded},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := newIsolatedError(tt.err).err()
+			if !errors.Is(err, tt.target) {
+				t.Errorf("isolatedError.err() = %v, want it to match %v", err, tt.target)
+			}
+		})
+	}
+
+	err := newIsolatedError(fmt.Errorf("could not delete: %w", &LockTimeoutError{Path: "profile.lock", Holder: "pid 1", Timeout: time.Minute})).err()
+
+	var lockErr *LockTimeoutError
+	if !errors.As(err, &lockErr) || lockErr.Holder != "pid 1" {
+		t.Errorf("isolatedError.err() = %v, want it to match the *LockTimeoutError it was raised with", err)
+	}
+
+	err = newIsolatedError(wrapError(reason.GuestStart, errors.New("start error"))).err()
+
+	var minikubeErr *MinikubeError
+	if !errors.As(err, &minikubeErr) {
+		t.Fatalf("isolatedError.err() = %T, want *MinikubeError", err)
+	}
+	if minikubeErr.Reason.ID != reason.GuestStart.ID || err.Error() != "start error" {
+		t.Errorf("isolatedError.err() = %v (%v), want start error (%v)", err, minikubeErr.Reason.ID, reason.GuestStart.ID)
+	}
+}
+
+func TestReadIsolatedResponse_Exit(t *testing.T) {
+	output := strings.Join([]string{
+		`{"specversion":"1.0","type":"io.k8s.sigs.minikube.step","data":{"currentstep":"1","name":"Creating Container"}}`,
+		`{"specversion":"1.0","type":"io.k8s.sigs.minikube.error","data":{"advice":"Restart Docker","exitcode":"80",` +
+			`"issues":"https://github.com/kubernetes/minikube/issues/7072","message":"docker is not running","name":"GUEST_PROVISION","url":""}}`,
+	}, "\n")
+
+	response, exit, err := readIsolatedResponse(strings.NewReader(output))
+	if err == nil || response != nil {
+		t.Fatalf("readIsolatedResponse() = %v, %v, want an error without a response", response, err)
+	}
+	if exit == nil {
+		t.Fatalf("readIsolatedResponse() did not find the reason minikube exited with")
+	}
+
+	err = exit.err("Start", "cluster")
+
+	var minikubeErr *MinikubeError
+	if !errors.As(err, &minikubeErr) {
+		t.Fatalf("isolatedExit.err() = %T, want *MinikubeError", err)
+	}
+	if minikubeErr.Reason.ID != "GUEST_PROVISION" || minikubeErr.Reason.ExitCode != 80 || minikubeErr.Reason.Advice != "Restart Docker" {
+		t.Errorf("isolatedExit.err() reason = %+v, want the reason minikube exited with", minikubeErr.Reason)
+	}
+	if !reflect.DeepEqual(minikubeErr.Reason.Issues, []int{7072}) {
+		t.Errorf("isolatedExit.err() issues = %v, want [7072]", minikubeErr.Reason.Issues)
+	}
+	if want := "minikube exited while running Start for cluster cluster: docker is not running"; err.Error() != want {
+		t.Errorf("isolatedExit.err() = %v, want %v", err, want)
+	}
+}
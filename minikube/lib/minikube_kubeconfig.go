@@ -0,0 +1,139 @@
+package lib
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+
+	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/client-go/tools/clientcmd/api"
+	"k8s.io/klog/v2"
+	"k8s.io/minikube/pkg/minikube/kubeconfig"
+)
+
+// KubeconfigRaw renders a self-contained kubeconfig for the cluster, with its certificates embedded rather than
+// referenced by path, so it can be used on hosts without access to MINIKUBE_HOME
+func KubeconfigRaw(kc *kubeconfig.Settings) (string, error) {
+	ca, err := os.ReadFile(kc.CertificateAuthority)
+	if err != nil {
+		return "", err
+	}
+
+	certificate, err := os.ReadFile(kc.ClientCertificate)
+	if err != nil {
+		return "", err
+	}
+
+	key, err := os.ReadFile(kc.ClientKey)
+	if err != nil {
+		return "", err
+	}
+
+	cluster := api.NewCluster()
+	cluster.Server = kc.ClusterServerAddress
+	cluster.CertificateAuthorityData = ca
+
+	user := api.NewAuthInfo()
+	user.ClientCertificateData = certificate
+	user.ClientKeyData = key
+
+	context := api.NewContext()
+	context.Cluster = kc.ClusterName
+	context.AuthInfo = kc.ClusterName
+	context.Namespace = kc.Namespace
+
+	cfg := api.NewConfig()
+	cfg.Clusters[kc.ClusterName] = cluster
+	cfg.AuthInfos[kc.ClusterName] = user
+	cfg.Contexts[kc.ClusterName] = context
+	cfg.CurrentContext = kc.ClusterName
+
+	raw, err := clientcmd.Write(*cfg)
+	if err != nil {
+		return "", err
+	}
+
+	return string(raw), nil
+}
+
+// CurrentContext reads the current-context of the kubeconfig at path, which is empty when the file does not exist
+func CurrentContext(path string) (string, error) {
+	cfg, err := clientcmd.LoadFromFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	return cfg.CurrentContext, nil
+}
+
+// RemoveContext removes the cluster, user and context entries minikube wrote for the cluster from the kubeconfig at path.
+// previous is only set when the cluster's context was the current one, and becomes the current-context again if it still exists.
+// Other entries are left untouched
+func RemoveContext(path string, name string, previous string) error {
+	cfg, err := clientcmd.LoadFromFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	_, hasCluster := cfg.Clusters[name]
+	_, hasUser := cfg.AuthInfos[name]
+	_, hasContext := cfg.Contexts[name]
+	changed := hasCluster || hasUser || hasContext
+
+	delete(cfg.Clusters, name)
+	delete(cfg.AuthInfos, name)
+	delete(cfg.Contexts, name)
+
+	if cfg.CurrentContext == name {
+		cfg.CurrentContext = ""
+		changed = true
+	}
+
+	if _, ok := cfg.Contexts[previous]; ok && previous != "" && cfg.CurrentContext != previous {
+		cfg.CurrentContext = previous
+		changed = true
+	}
+
+	if !changed {
+		return nil
+	}
+
+	return clientcmd.WriteToFile(*cfg, path)
+}
+
+// useKubeconfigPath points minikube at a dedicated kubeconfig for the duration of an operation, as minikube
+// only ever writes to the file named by KUBECONFIG. The returned func restores the previous environment.
+// Must be called while holding TfCreationLock, as the environment is shared by the whole process
+func useKubeconfigPath(path string) (func(), error) {
+	if path == "" {
+		return func() {}, nil
+	}
+
+	previous, set := os.LookupEnv(clientcmd.RecommendedConfigPathEnvVar)
+	err := os.Setenv(clientcmd.RecommendedConfigPathEnvVar, path)
+	if err != nil {
+		return nil, err
+	}
+
+	return func() {
+		if set {
+			os.Setenv(clientcmd.RecommendedConfigPathEnvVar, previous)
+		} else {
+			os.Unsetenv(clientcmd.RecommendedConfigPathEnvVar)
+		}
+
+		// The file holds the client key, so keep it private even if it existed beforehand
+		if _, err := os.Stat(path); err != nil {
+			return
+		}
+		if err := os.Chmod(path, 0600); err != nil {
+			klog.Warningf("could not restrict the permissions of %s: %v", path, err)
+		}
+	}, nil
+}
@@ -0,0 +1,178 @@
+package lib
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/client-go/tools/clientcmd/api"
+	"k8s.io/minikube/pkg/minikube/kubeconfig"
+)
+
+func TestKubeconfigRaw(t *testing.T) {
+	dir := t.TempDir()
+	for _, f := range []string{"ca.crt", "client.crt", "client.key"} {
+		if err := os.WriteFile(filepath.Join(dir, f), []byte(f), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	raw, err := KubeconfigRaw(&kubeconfig.Settings{
+		ClusterName:          "cluster",
+		ClusterServerAddress: "https://192.168.49.2:8443",
+		CertificateAuthority: filepath.Join(dir, "ca.crt"),
+		ClientCertificate:    filepath.Join(dir, "client.crt"),
+		ClientKey:            filepath.Join(dir, "client.key"),
+	})
+	if err != nil {
+		t.Fatalf("KubeconfigRaw() error = %v", err)
+	}
+
+	cfg, err := clientcmd.Load([]byte(raw))
+	if err != nil {
+		t.Fatalf("KubeconfigRaw() is not a valid kubeconfig: %v", err)
+	}
+
+	if cfg.CurrentContext != "cluster" {
+		t.Errorf("CurrentContext = %v, want cluster", cfg.CurrentContext)
+	}
+	if got := cfg.Clusters["cluster"].Server; got != "https://192.168.49.2:8443" {
+		t.Errorf("Server = %v, want https://192.168.49.2:8443", got)
+	}
+	if got := string(cfg.Clusters["cluster"].CertificateAuthorityData); got != "ca.crt" {
+		t.Errorf("CertificateAuthorityData = %v, want the embedded certificate", got)
+	}
+	if got := string(cfg.AuthInfos["cluster"].ClientKeyData); got != "client.key" {
+		t.Errorf("ClientKeyData = %v, want the embedded key", got)
+	}
+}
+
+func TestKubeconfigRaw_MissingCertificate(t *testing.T) {
+	_, err := KubeconfigRaw(&kubeconfig.Settings{
+		ClusterName:          "cluster",
+		CertificateAuthority: filepath.Join(t.TempDir(), "ca.crt"),
+	})
+	if err == nil {
+		t.Errorf("KubeconfigRaw() expected an error for a missing certificate")
+	}
+}
+
+func TestUseKubeconfigPath(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config")
+	t.Setenv(clientcmd.RecommendedConfigPathEnvVar, "/previous/config")
+
+	restore, err := useKubeconfigPath(path)
+	if err != nil {
+		t.Fatalf("useKubeconfigPath() error = %v", err)
+	}
+
+	if got := os.Getenv(clientcmd.RecommendedConfigPathEnvVar); got != path {
+		t.Errorf("KUBECONFIG = %v, want %v", got, path)
+	}
+
+	// minikube writes the file while the path is in use
+	if err := os.WriteFile(path, []byte("apiVersion: v1"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	restore()
+
+	if got := os.Getenv(clientcmd.RecommendedConfigPathEnvVar); got != "/previous/config" {
+		t.Errorf("KUBECONFIG = %v, want /previous/config", got)
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Mode().Perm() != 0600 {
+		t.Errorf("kubeconfig mode = %v, want 0600", info.Mode().Perm())
+	}
+}
+
+func TestCurrentContext(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config")
+
+	current, err := CurrentContext(path)
+	if err != nil || current != "" {
+		t.Errorf("CurrentContext() = %v, %v, want an empty context for a missing file", current, err)
+	}
+
+	writeKubeconfig(t, path, "other", "cluster", "other")
+
+	current, err = CurrentContext(path)
+	if err != nil || current != "other" {
+		t.Errorf("CurrentContext() = %v, %v, want other", current, err)
+	}
+}
+
+func TestRemoveContext(t *testing.T) {
+	tests := []struct {
+		name     string
+		current  string
+		previous string
+		want     string
+	}{
+		{name: "Restores Previous Context", current: "cluster", previous: "other", want: "other"},
+		{name: "Unsets Current Context", current: "cluster", previous: "", want: ""},
+		{name: "Previous Context Removed", current: "cluster", previous: "gone", want: ""},
+		{name: "Keeps Unrelated Current Context", current: "other", previous: "", want: "other"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "config")
+			writeKubeconfig(t, path, tt.current, "cluster", "other")
+
+			if err := RemoveContext(path, "cluster", tt.previous); err != nil {
+				t.Fatalf("RemoveContext() error = %v", err)
+			}
+
+			cfg, err := clientcmd.LoadFromFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if cfg.CurrentContext != tt.want {
+				t.Errorf("CurrentContext = %v, want %v", cfg.CurrentContext, tt.want)
+			}
+			if _, ok := cfg.Clusters["cluster"]; ok {
+				t.Errorf("the cluster entry was left behind")
+			}
+			if _, ok := cfg.AuthInfos["cluster"]; ok {
+				t.Errorf("the user entry was left behind")
+			}
+			if _, ok := cfg.Contexts["cluster"]; ok {
+				t.Errorf("the context entry was left behind")
+			}
+			if _, ok := cfg.Contexts["other"]; !ok {
+				t.Errorf("an unrelated context was removed")
+			}
+		})
+	}
+}
+
+func TestRemoveContext_MissingFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config")
+	if err := RemoveContext(path, "cluster", ""); err != nil {
+		t.Errorf("RemoveContext() error = %v", err)
+	}
+
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Errorf("RemoveContext() created %s", path)
+	}
+}
+
+// writeKubeconfig writes a kubeconfig holding a cluster, user and context for each name
+func writeKubeconfig(t *testing.T, path string, current string, names ...string) {
+	cfg := api.NewConfig()
+	for _, name := range names {
+		cfg.Clusters[name] = &api.Cluster{Server: "https://127.0.0.1:8443"}
+		cfg.AuthInfos[name] = &api.AuthInfo{Token: name}
+		cfg.Contexts[name] = &api.Context{Cluster: name, AuthInfo: name}
+	}
+	cfg.CurrentContext = current
+
+	if err := clientcmd.WriteToFile(*cfg, path); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,162 @@
+package lib
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/gofrs/flock"
+	"k8s.io/klog/v2"
+	"k8s.io/minikube/pkg/minikube/localpath"
+)
+
+// lockRetryDelay is how often a busy lock is tried again
+var lockRetryDelay = time.Second
+
+// holders numbers the locks taken by this process, so that each holder keeps its own record
+var holders atomic.Int64
+
+// LockTimeoutError is raised when a lock is still held by another process once the lock timeout has passed
+type LockTimeoutError struct {
+	Path    string
+	Holder  string
+	Timeout time.Duration
+}
+
+func (e *LockTimeoutError) Error() string {
+	return fmt.Sprintf("%s is held by %s, gave up waiting after %s. Wait for that operation to finish, or raise the provider's lock_timeout",
+		e.Path, e.Holder, e.Timeout)
+}
+
+// fileLock is an advisory lock on a file under MINIKUBE_HOME. Locks are released by the operating system when their
+// process exits, so a lock can never outlive its holder. Only processes taking the same locks are kept out, i.e. other
+// terraform runs. The minikube CLI is not: it only takes minikube's own locks, and only for the moment it writes a
+// profile or provisions a machine. Holding those across an operation would block minikube itself, which takes them
+// again from within the operation
+type fileLock struct {
+	flock *flock.Flock
+	// record is the file naming this holder to processes left waiting
+	record string
+}
+
+// profileLockPath is the lock of a single profile. It lives outside of the profile directory, which is removed on delete
+func profileLockPath(name string) string {
+	return filepath.Join(localpath.MiniPath(), "locks", fmt.Sprintf("profile-%s.lock", name))
+}
+
+// cacheLockPath is the lock of the download cache shared by every profile
+func cacheLockPath() string {
+	return filepath.Join(localpath.MiniPath(), "locks", "cache.lock")
+}
+
+// acquireLock takes the lock at path, waiting up to timeout for other processes to release it.
+// Shared locks may be held by several processes at once, whereas an exclusive lock keeps out every other holder.
+// operation describes what the lock is taken for, and is reported to processes left waiting
+func acquireLock(ctx context.Context, path string, exclusive bool, timeout time.Duration, operation string) (*fileLock, error) {
+	err := os.MkdirAll(filepath.Dir(path), 0755)
+	if err != nil {
+		return nil, err
+	}
+
+	l := &fileLock{flock: flock.New(path)}
+
+	try := l.flock.TryRLock
+	tryContext := l.flock.TryRLockContext
+	if exclusive {
+		try = l.flock.TryLock
+		tryContext = l.flock.TryLockContext
+	}
+
+	locked, err := try()
+	if err == nil && !locked && timeout > 0 {
+		klog.Infof("waiting up to %s for %s, held by %s", timeout, path, lockHolder(path))
+
+		waitCtx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+		locked, err = tryContext(waitCtx, lockRetryDelay)
+	}
+
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	if errors.Is(err, context.DeadlineExceeded) || (err == nil && !locked) {
+		return nil, &LockTimeoutError{Path: path, Holder: lockHolder(path), Timeout: timeout}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not lock %s: %w", path, err)
+	}
+
+	// An exclusive holder is the only one, so any records left are stale, e.g. from a process that was killed
+	if exclusive {
+		for _, record := range holderRecords(path) {
+			os.Remove(record)
+		}
+	}
+
+	l.record = fmt.Sprintf("%s.holder.%d.%d", path, os.Getpid(), holders.Add(1))
+	err = os.WriteFile(l.record, []byte(describeHolder(operation)), 0644)
+	if err != nil {
+		klog.Warningf("could not record the holder of %s: %v", path, err)
+	}
+
+	return l, nil
+}
+
+// Unlock clears the record of the holder and releases the lock
+func (l *fileLock) Unlock() {
+	os.Remove(l.record)
+
+	if err := l.flock.Unlock(); err != nil {
+		klog.Warningf("could not release %s: %v", l.flock.Path(), err)
+	}
+}
+
+// holderRecords lists the records of the current holders of the lock at path, one per holder
+func holderRecords(path string) []string {
+	entries, err := os.ReadDir(filepath.Dir(path))
+	if err != nil {
+		return nil
+	}
+
+	prefix := filepath.Base(path) + ".holder."
+	var records []string
+	for _, entry := range entries {
+		if strings.HasPrefix(entry.Name(), prefix) {
+			records = append(records, filepath.Join(filepath.Dir(path), entry.Name()))
+		}
+	}
+
+	return records
+}
+
+// describeHolder names the current process as the holder of a lock
+func describeHolder(operation string) string {
+	host, err := os.Hostname()
+	if err != nil {
+		host = "unknown host"
+	}
+
+	return fmt.Sprintf("pid %d on %s (%s, since %s)", os.Getpid(), host, operation, time.Now().Format(time.RFC3339))
+}
+
+// lockHolder reads the holders recorded for a lock. Shared locks may have several
+func lockHolder(path string) string {
+	var holders []string
+	for _, record := range holderRecords(path) {
+		holder, err := os.ReadFile(record)
+		if err == nil && len(holder) > 0 {
+			holders = append(holders, strings.TrimSpace(string(holder)))
+		}
+	}
+
+	if len(holders) == 0 {
+		return "an unknown process"
+	}
+
+	return strings.Join(holders, " and ")
+}
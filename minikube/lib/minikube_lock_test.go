@@ -0,0 +1,100 @@
+package lib
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestAcquireLock(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "locks", "profile-cluster.lock")
+
+	held, err := acquireLock(context.Background(), path, true, 0, "start of cluster cluster")
+	if err != nil {
+		t.Fatalf("acquireLock() error = %v", err)
+	}
+
+	_, err = acquireLock(context.Background(), path, true, 0, "delete of cluster cluster")
+	var timeoutErr *LockTimeoutError
+	if !errors.As(err, &timeoutErr) {
+		t.Fatalf("acquireLock() error = %v, want a LockTimeoutError", err)
+	}
+	if !strings.Contains(err.Error(), "start of cluster cluster") {
+		t.Errorf("acquireLock() error = %v, want it to name the holder", err)
+	}
+
+	held.Unlock()
+
+	released, err := acquireLock(context.Background(), path, true, 0, "delete of cluster cluster")
+	if err != nil {
+		t.Fatalf("acquireLock() error = %v once released", err)
+	}
+	released.Unlock()
+}
+
+func TestAcquireLock_Shared(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cache.lock")
+
+	first, err := acquireLock(context.Background(), path, false, 0, "start of cluster first")
+	if err != nil {
+		t.Fatalf("acquireLock() error = %v", err)
+	}
+
+	second, err := acquireLock(context.Background(), path, false, 0, "start of cluster second")
+	if err != nil {
+		t.Fatalf("acquireLock() error = %v, want shared locks to be held together", err)
+	}
+	defer second.Unlock()
+
+	_, err = acquireLock(context.Background(), path, true, 0, "purge of cluster third")
+	var timeoutErr *LockTimeoutError
+	if !errors.As(err, &timeoutErr) {
+		t.Fatalf("acquireLock() error = %v, want an exclusive lock to wait for shared holders", err)
+	}
+	if !strings.Contains(err.Error(), "start of cluster first") || !strings.Contains(err.Error(), "start of cluster second") {
+		t.Errorf("acquireLock() error = %v, want it to name both holders", err)
+	}
+
+	first.Unlock()
+
+	_, err = acquireLock(context.Background(), path, true, 0, "purge of cluster third")
+	if err == nil || strings.Contains(err.Error(), "start of cluster first") || !strings.Contains(err.Error(), "start of cluster second") {
+		t.Errorf("acquireLock() error = %v, want it to only name the remaining holder", err)
+	}
+}
+
+func TestAcquireLock_Wait(t *testing.T) {
+	defer func(delay time.Duration) { lockRetryDelay = delay }(lockRetryDelay)
+	lockRetryDelay = 10 * time.Millisecond
+
+	path := filepath.Join(t.TempDir(), "profile-cluster.lock")
+
+	held, err := acquireLock(context.Background(), path, true, 0, "start of cluster cluster")
+	if err != nil {
+		t.Fatalf("acquireLock() error = %v", err)
+	}
+	time.AfterFunc(50*time.Millisecond, held.Unlock)
+
+	waited, err := acquireLock(context.Background(), path, true, time.Minute, "delete of cluster cluster")
+	if err != nil {
+		t.Fatalf("acquireLock() error = %v, want the lock once released", err)
+	}
+	waited.Unlock()
+
+	blocker, err := acquireLock(context.Background(), path, true, 0, "start of cluster cluster")
+	if err != nil {
+		t.Fatalf("acquireLock() error = %v", err)
+	}
+	defer blocker.Unlock()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(50*time.Millisecond, cancel)
+
+	_, err = acquireLock(ctx, path, true, time.Minute, "delete of cluster cluster")
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("acquireLock() error = %v, want %v", err, context.Canceled)
+	}
+}
@@ -0,0 +1,163 @@
+package lib
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"k8s.io/minikube/pkg/minikube/cluster"
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/localpath"
+)
+
+const (
+	RoleWorker       = "worker"
+	RoleControlPlane = "control-plane"
+)
+
+// NodeOptions holds the settings accepted by `minikube node add`
+type NodeOptions struct {
+	// Role is either a worker or an additional control plane (--control-plane)
+	Role string
+	// ContainerRuntime defaults to the container runtime of the cluster when empty
+	ContainerRuntime string
+	// KubernetesVersion defaults to the Kubernetes version of the cluster when empty
+	KubernetesVersion string
+}
+
+// NodeRole returns the role of an existing node
+func NodeRole(n config.Node) string {
+	if n.ControlPlane {
+		return RoleControlPlane
+	}
+
+	return RoleWorker
+}
+
+// findNode looks up a node of the cluster by name
+func findNode(cc *config.ClusterConfig, name string) (*config.Node, error) {
+	for _, n := range cc.Nodes {
+		if n.Name == name {
+			return &n, nil
+		}
+	}
+
+	return nil, fmt.Errorf("node %s does not exist in cluster %s", name, cc.Name)
+}
+
+// ExistingNodes returns the nodes of the profile whose machine still exists, leaving out nodes removed by hand
+func ExistingNodes(cc *config.ClusterConfig, statuses []*cluster.Status) []config.Node {
+	missing := make(map[string]bool)
+	for _, s := range statuses {
+		if s != nil && s.Host == cluster.Nonexistent {
+			missing[s.Name] = true
+		}
+	}
+
+	nodes := make([]config.Node, 0, len(cc.Nodes))
+	for _, n := range cc.Nodes {
+		if !missing[config.MachineName(*cc, n)] {
+			nodes = append(nodes, n)
+		}
+	}
+
+	return nodes
+}
+
+// ControlPlanes counts the control plane nodes among the given nodes
+func ControlPlanes(nodes []config.Node) int {
+	count := 0
+	for _, n := range nodes {
+		if n.ControlPlane {
+			count++
+		}
+	}
+
+	return count
+}
+
+// attachedNodesPath lists the nodes attached to a cluster through AddNode. It lives in the profile directory, so that
+// it is removed along with the cluster
+func attachedNodesPath(clusterName string) string {
+	return filepath.Join(localpath.MiniPath(), "profiles", clusterName, "terraform-attached-nodes.json")
+}
+
+// AttachedNodes returns the names of the nodes attached to a cluster one at a time through AddNode. These are managed
+// by their own minikube_node resource, so they neither count towards the nodes of the cluster nor are scaled by it
+func AttachedNodes(clusterName string) ([]string, error) {
+	data, err := os.ReadFile(attachedNodesPath(clusterName))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	err = json.Unmarshal(data, &names)
+	if err != nil {
+		return nil, fmt.Errorf("could not read the attached nodes of cluster %s: %w", clusterName, err)
+	}
+
+	return names, nil
+}
+
+// SetNodeAttached records whether a node of the cluster is attached through AddNode, see AttachedNodes
+func SetNodeAttached(clusterName string, name string, attached bool) error {
+	names, err := AttachedNodes(clusterName)
+	if err != nil {
+		return err
+	}
+
+	updated := make([]string, 0, len(names)+1)
+	for _, n := range names {
+		if n != name {
+			updated = append(updated, n)
+		}
+	}
+	if !attached && len(updated) == len(names) {
+		return nil
+	}
+	if attached {
+		updated = append(updated, name)
+	}
+
+	data, err := json.Marshal(updated)
+	if err != nil {
+		return err
+	}
+
+	path := attachedNodesPath(clusterName)
+	err = os.MkdirAll(filepath.Dir(path), 0755)
+	if err != nil {
+		return err
+	}
+
+	// Replace the file in one step, so that a concurrent read never sees it half written
+	err = os.WriteFile(path+".tmp", data, 0644)
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(path+".tmp", path)
+}
+
+// OwnedNodes leaves the attached nodes out of the given nodes, returning the ones managed through the node count
+// of the cluster
+func OwnedNodes(nodes []config.Node, attached []string) []config.Node {
+	skip := make(map[string]bool, len(attached))
+	for _, name := range attached {
+		skip[name] = true
+	}
+
+	owned := make([]config.Node, 0, len(nodes))
+	for _, n := range nodes {
+		if !skip[n.Name] {
+			owned = append(owned, n)
+		}
+	}
+
+	return owned
+}
@@ -0,0 +1,86 @@
+package lib
+
+import (
+	"os"
+	"reflect"
+	"testing"
+
+	"k8s.io/minikube/pkg/minikube/cluster"
+	"k8s.io/minikube/pkg/minikube/config"
+)
+
+func TestExistingNodes(t *testing.T) {
+	cc := &config.ClusterConfig{
+		Name: "cluster",
+		Nodes: []config.Node{
+			{Name: "", ControlPlane: true, Worker: true},
+			{Name: "m02", ControlPlane: true, Worker: true},
+			{Name: "m03", ControlPlane: true, Worker: true},
+			{Name: "m04", Worker: true},
+		},
+	}
+
+	tests := []struct {
+		name              string
+		statuses          []*cluster.Status
+		wantNodes         int
+		wantControlPlanes int
+	}{
+		{
+			name:              "All Machines Exist",
+			statuses:          []*cluster.Status{{Name: "cluster", Host: "Running"}, {Name: "cluster-m02", Host: "Running"}, {Name: "cluster-m03", Host: "Running"}, {Name: "cluster-m04", Host: "Stopped"}},
+			wantNodes:         4,
+			wantControlPlanes: 3,
+		},
+		{
+			name:              "Machines Removed By Hand",
+			statuses:          []*cluster.Status{{Name: "cluster", Host: "Running"}, {Name: "cluster-m02", Host: cluster.Nonexistent}, {Name: "cluster-m03", Host: "Running"}, {Name: "cluster-m04", Host: cluster.Nonexistent}},
+			wantNodes:         2,
+			wantControlPlanes: 2,
+		},
+		{
+			name:              "Unknown Machine State",
+			statuses:          nil,
+			wantNodes:         4,
+			wantControlPlanes: 3,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nodes := ExistingNodes(cc, tt.statuses)
+			if len(nodes) != tt.wantNodes {
+				t.Errorf("ExistingNodes() = %v nodes, want %v", len(nodes), tt.wantNodes)
+			}
+			if got := ControlPlanes(nodes); got != tt.wantControlPlanes {
+				t.Errorf("ControlPlanes() = %v, want %v", got, tt.wantControlPlanes)
+			}
+		})
+	}
+}
+
+func TestAttachedNodes(t *testing.T) {
+	t.Cleanup(func() { os.Remove(attachedNodesPath("attached")) })
+
+	for _, name := range []string{"m02", "m03", "m04"} {
+		if err := SetNodeAttached("attached", name, true); err != nil {
+			t.Fatalf("SetNodeAttached() error = %v", err)
+		}
+	}
+	if err := SetNodeAttached("attached", "m03", false); err != nil {
+		t.Fatalf("SetNodeAttached() error = %v", err)
+	}
+
+	attached, err := AttachedNodes("attached")
+	if err != nil {
+		t.Fatalf("AttachedNodes() error = %v", err)
+	}
+	if !reflect.DeepEqual(attached, []string{"m02", "m04"}) {
+		t.Errorf("AttachedNodes() = %v, want [m02 m04]", attached)
+	}
+
+	nodes := []config.Node{{Name: ""}, {Name: "m02"}, {Name: "m03"}, {Name: "m04"}}
+	owned := OwnedNodes(nodes, attached)
+	if !reflect.DeepEqual(owned, []config.Node{{Name: ""}, {Name: "m03"}}) {
+		t.Errorf("OwnedNodes() = %v, want the primary control plane and m03", owned)
+	}
+}
@@ -0,0 +1,83 @@
+package lib
+
+import (
+	"k8s.io/minikube/pkg/libmachine/state"
+	"k8s.io/minikube/pkg/minikube/cluster"
+)
+
+const (
+	StateRunning = "running"
+	StateStopped = "stopped"
+	StatePaused  = "paused"
+	StateInvalid = "invalid"
+
+	HealthHealthy  = "healthy"
+	HealthDegraded = "degraded"
+)
+
+// Profile summarises a minikube profile found under MINIKUBE_HOME
+type Profile struct {
+	Name              string
+	Driver            string
+	Status            string
+	Nodes             int
+	KubernetesVersion string
+}
+
+// SummariseStatus reduces the status of each node into the state of the cluster as a whole, the way `minikube status` does.
+// A cluster is only running once every host and kubelet, and the apiserver of every control plane, is running.
+// Nodes whose machine no longer exists are left out
+func SummariseStatus(statuses []*cluster.Status) string {
+	for _, s := range statuses {
+		if s != nil && s.APIServer == state.Paused.String() {
+			return StatePaused
+		}
+	}
+
+	running := 0
+	for _, s := range statuses {
+		if s == nil || s.Host == cluster.Nonexistent {
+			continue
+		}
+
+		if s.Host != state.Running.String() || s.Kubelet != state.Running.String() {
+			return StateStopped
+		}
+
+		if !s.Worker && s.APIServer != state.Running.String() {
+			return StateStopped
+		}
+
+		running++
+	}
+
+	if running == 0 {
+		return StateStopped
+	}
+
+	return StateRunning
+}
+
+// SummariseHealth describes how healthy the cluster is as a whole. Unlike SummariseStatus, a cluster that is only partially
+// running is reported as degraded rather than stopped
+func SummariseHealth(statuses []*cluster.Status) string {
+	switch SummariseStatus(statuses) {
+	case StatePaused:
+		return StatePaused
+	case StateRunning:
+		for _, s := range statuses {
+			if s != nil && !s.Worker && s.Host != cluster.Nonexistent && s.Kubeconfig != "" && s.Kubeconfig != cluster.Configured {
+				return HealthDegraded
+			}
+		}
+		return HealthHealthy
+	}
+
+	for _, s := range statuses {
+		if s != nil && s.Host == state.Running.String() {
+			return HealthDegraded
+		}
+	}
+
+	return StateStopped
+}
@@ -0,0 +1,126 @@
+package lib
+
+import (
+	"testing"
+
+	"k8s.io/minikube/pkg/minikube/cluster"
+)
+
+func TestSummariseStatus(t *testing.T) {
+	tests := []struct {
+		name     string
+		statuses []*cluster.Status
+		want     string
+	}{
+		{
+			name: "Running",
+			statuses: []*cluster.Status{
+				{Host: "Running", Kubelet: "Running", APIServer: "Running"},
+				{Host: "Running", Kubelet: "Running", Worker: true},
+			},
+			want: StateRunning,
+		},
+		{
+			name: "Paused",
+			statuses: []*cluster.Status{
+				{Host: "Running", Kubelet: "Stopped", APIServer: "Paused"},
+			},
+			want: StatePaused,
+		},
+		{
+			name: "Stopped",
+			statuses: []*cluster.Status{
+				{Host: "Stopped", Kubelet: "Stopped", APIServer: "Stopped"},
+			},
+			want: StateStopped,
+		},
+		{
+			name: "Stopped After Host Reboot",
+			statuses: []*cluster.Status{
+				{Host: "Running", Kubelet: "Stopped", APIServer: "Stopped"},
+				{Host: "Running", Kubelet: "Running", Worker: true},
+			},
+			want: StateStopped,
+		},
+		{
+			name: "Partially Stopped",
+			statuses: []*cluster.Status{
+				{Host: "Running", Kubelet: "Running", APIServer: "Running"},
+				{Host: "Stopped", Kubelet: "Stopped", Worker: true},
+			},
+			want: StateStopped,
+		},
+		{
+			name: "Ignores Removed Machines",
+			statuses: []*cluster.Status{
+				{Host: "Running", Kubelet: "Running", APIServer: "Running"},
+				{Host: cluster.Nonexistent, Kubelet: cluster.Nonexistent, Worker: true},
+			},
+			want: StateRunning,
+		},
+		{
+			name:     "No Nodes",
+			statuses: []*cluster.Status{},
+			want:     StateStopped,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SummariseStatus(tt.statuses); got != tt.want {
+				t.Errorf("SummariseStatus() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSummariseHealth(t *testing.T) {
+	tests := []struct {
+		name     string
+		statuses []*cluster.Status
+		want     string
+	}{
+		{
+			name: "Healthy",
+			statuses: []*cluster.Status{
+				{Host: "Running", Kubelet: "Running", APIServer: "Running", Kubeconfig: "Configured"},
+				{Host: "Running", Kubelet: "Running", Worker: true},
+			},
+			want: HealthHealthy,
+		},
+		{
+			name: "Misconfigured Kubeconfig",
+			statuses: []*cluster.Status{
+				{Host: "Running", Kubelet: "Running", APIServer: "Running", Kubeconfig: "Misconfigured"},
+			},
+			want: HealthDegraded,
+		},
+		{
+			name: "Apiserver Not Serving",
+			statuses: []*cluster.Status{
+				{Host: "Running", Kubelet: "Running", APIServer: "Stopped", Kubeconfig: "Configured"},
+			},
+			want: HealthDegraded,
+		},
+		{
+			name: "Paused",
+			statuses: []*cluster.Status{
+				{Host: "Running", Kubelet: "Stopped", APIServer: "Paused", Kubeconfig: "Configured"},
+			},
+			want: StatePaused,
+		},
+		{
+			name: "Stopped",
+			statuses: []*cluster.Status{
+				{Host: "Stopped", Kubelet: "Stopped", APIServer: "Stopped", Kubeconfig: "Stopped"},
+			},
+			want: StateStopped,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SummariseHealth(tt.statuses); got != tt.want {
+				t.Errorf("SummariseHealth() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
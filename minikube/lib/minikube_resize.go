@@ -0,0 +1,150 @@
+package lib
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strconv"
+
+	"k8s.io/minikube/pkg/libmachine"
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/machine"
+	"k8s.io/minikube/pkg/minikube/reason"
+)
+
+const (
+	ResourceCPUs   = "cpus"
+	ResourceMemory = "memory"
+)
+
+// resizableResources lists the machine resources each driver can change on an existing machine.
+// Disks are sized when a machine is created, so a new disk size always recreates the cluster
+var resizableResources = map[string][]string{
+	Docker: {ResourceCPUs, ResourceMemory},
+	Podman: {ResourceCPUs, ResourceMemory},
+	KVM2:   {ResourceCPUs, ResourceMemory},
+	QEMU2:  {ResourceCPUs, ResourceMemory},
+}
+
+// CanResize reports whether the given resource of an existing machine can be changed in place with the given driver
+func CanResize(driver string, resource string) bool {
+	for _, r := range resizableResources[driver] {
+		if r == resource {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Resize changes the cpus and memory (in MB) of every stopped machine of the cluster. The new values are picked up on the next start
+func (m *MinikubeCluster) Resize(ctx context.Context, cc *config.ClusterConfig, cpus int, memory int) (err error) {
+	defer recoverError(reason.GuestProvision, &err)
+
+	api, err := machine.NewAPIClient()
+	if err != nil {
+		return wrapError(reason.NewAPIClient, err)
+	}
+	defer api.Close()
+
+	for _, n := range cc.Nodes {
+		if err = ctx.Err(); err != nil {
+			return err
+		}
+
+		name := config.MachineName(*cc, n)
+
+		switch cc.Driver {
+		case Docker, Podman:
+			err = resizeContainer(ctx, cc.Driver, name, cpus, memory)
+		case KVM2:
+			err = resizeDomain(ctx, cc.KVMQemuURI, name, cpus, memory)
+			if err == nil {
+				err = resizeDriverConfig(api, name, cpus, memory)
+			}
+		case QEMU2:
+			err = resizeDriverConfig(api, name, cpus, memory)
+		default:
+			err = fmt.Errorf("the %s driver cannot resize machines in place", cc.Driver)
+		}
+
+		if err != nil {
+			return wrapError(reason.GuestProvision, err)
+		}
+	}
+
+	return nil
+}
+
+// resizeContainer updates the resource limits of a kic container, mirroring the limits minikube sets on creation
+func resizeContainer(ctx context.Context, ociBin string, name string, cpus int, memory int) error {
+	if memory == 0 {
+		return errors.New("the memory limit of an existing container cannot be lifted, recreate the cluster to use no-limit")
+	}
+
+	mem := strconv.Itoa(memory) + "m"
+	args := []string{"update", "--cpus", strconv.Itoa(cpus), "--memory", mem, "--memory-swap", mem, name}
+
+	out, err := exec.CommandContext(ctx, ociBin, args...).CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("%s update %s: %w: %s", ociBin, name, err, out)
+	}
+
+	return nil
+}
+
+// resizeDomain updates the persistent definition of a libvirt domain. The maximum has to be raised before the current value
+func resizeDomain(ctx context.Context, uri string, name string, cpus int, memory int) error {
+	mem := strconv.Itoa(memory) + "MiB"
+	commands := [][]string{
+		{"setmaxmem", name, mem, "--config"},
+		{"setmem", name, mem, "--config"},
+		{"setvcpus", name, strconv.Itoa(cpus), "--config", "--maximum"},
+		{"setvcpus", name, strconv.Itoa(cpus), "--config"},
+	}
+
+	for _, c := range commands {
+		out, err := exec.CommandContext(ctx, "virsh", append([]string{"-c", uri}, c...)...).CombinedOutput()
+		if err != nil {
+			return fmt.Errorf("virsh %s %s: %w: %s", c[0], name, err, out)
+		}
+	}
+
+	return nil
+}
+
+// resizeDriverConfig updates the cpus and memory stored in the machine's driver config, which the VM drivers read on start
+func resizeDriverConfig(api libmachine.API, name string, cpus int, memory int) error {
+	h, err := machine.LoadHost(api, name)
+	if err != nil {
+		return err
+	}
+
+	raw, err := json.Marshal(h.Driver)
+	if err != nil {
+		return err
+	}
+
+	var driverConfig map[string]interface{}
+	err = json.Unmarshal(raw, &driverConfig)
+	if err != nil {
+		return err
+	}
+
+	driverConfig["CPU"] = cpus
+	driverConfig["Memory"] = memory
+
+	raw, err = json.Marshal(driverConfig)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(raw, h.Driver)
+	if err != nil {
+		return err
+	}
+
+	return api.Save(h)
+}
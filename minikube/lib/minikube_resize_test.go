@@ -0,0 +1,26 @@
+package lib
+
+import "testing"
+
+func TestCanResize(t *testing.T) {
+	tests := []struct {
+		name     string
+		driver   string
+		resource string
+		want     bool
+	}{
+		{name: "Docker Memory", driver: Docker, resource: ResourceMemory, want: true},
+		{name: "Podman CPUs", driver: Podman, resource: ResourceCPUs, want: true},
+		{name: "KVM2 CPUs", driver: KVM2, resource: ResourceCPUs, want: true},
+		{name: "QEMU2 Memory", driver: QEMU2, resource: ResourceMemory, want: true},
+		{name: "Docker Disk", driver: Docker, resource: "disk_size", want: false},
+		{name: "Hyperkit Memory", driver: "hyperkit", resource: ResourceMemory, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CanResize(tt.driver, tt.resource); got != tt.want {
+				t.Errorf("CanResize() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -5,9 +5,11 @@
 package lib
 
 import (
+	context "context"
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
+	cluster "k8s.io/minikube/pkg/minikube/cluster"
 	config "k8s.io/minikube/pkg/minikube/config"
 	kubeconfig "k8s.io/minikube/pkg/minikube/kubeconfig"
 )
@@ -35,32 +37,89 @@ func (m *MockClusterClient) EXPECT() *MockClusterClientMockRecorder {
 	return m.recorder
 }
 
+// AddNode mocks base method.
+func (m *MockClusterClient) AddNode(ctx context.Context, options NodeOptions) (*config.Node, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddNode", ctx, options)
+	ret0, _ := ret[0].(*config.Node)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddNode indicates an expected call of AddNode.
+func (mr *MockClusterClientMockRecorder) AddNode(ctx, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddNode", reflect.TypeOf((*MockClusterClient)(nil).AddNode), ctx, options)
+}
+
 // ApplyAddons mocks base method.
-func (m *MockClusterClient) ApplyAddons(addons []string) error {
+func (m *MockClusterClient) ApplyAddons(ctx context.Context, addons []string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ApplyAddons", addons)
+	ret := m.ctrl.Call(m, "ApplyAddons", ctx, addons)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // ApplyAddons indicates an expected call of ApplyAddons.
-func (mr *MockClusterClientMockRecorder) ApplyAddons(addons interface{}) *gomock.Call {
+func (mr *MockClusterClientMockRecorder) ApplyAddons(ctx, addons interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyAddons", reflect.TypeOf((*MockClusterClient)(nil).ApplyAddons), ctx, addons)
+}
+
+// AttachNode mocks base method.
+func (m *MockClusterClient) AttachNode(ctx context.Context, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AttachNode", ctx, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AttachNode indicates an expected call of AttachNode.
+func (mr *MockClusterClientMockRecorder) AttachNode(ctx, name interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyAddons", reflect.TypeOf((*MockClusterClient)(nil).ApplyAddons), addons)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttachNode", reflect.TypeOf((*MockClusterClient)(nil).AttachNode), ctx, name)
 }
 
 // Delete mocks base method.
-func (m *MockClusterClient) Delete() error {
+func (m *MockClusterClient) Delete(ctx context.Context) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete")
+	ret := m.ctrl.Call(m, "Delete", ctx)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete.
-func (mr *MockClusterClientMockRecorder) Delete() *gomock.Call {
+func (mr *MockClusterClientMockRecorder) Delete(ctx interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockClusterClient)(nil).Delete))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockClusterClient)(nil).Delete), ctx)
+}
+
+// DisableAddon mocks base method.
+func (m *MockClusterClient) DisableAddon(ctx context.Context, addon string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisableAddon", ctx, addon)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DisableAddon indicates an expected call of DisableAddon.
+func (mr *MockClusterClientMockRecorder) DisableAddon(ctx, addon interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableAddon", reflect.TypeOf((*MockClusterClient)(nil).DisableAddon), ctx, addon)
+}
+
+// EnableAddon mocks base method.
+func (m *MockClusterClient) EnableAddon(ctx context.Context, addon string, options AddonOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnableAddon", ctx, addon, options)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EnableAddon indicates an expected call of EnableAddon.
+func (mr *MockClusterClientMockRecorder) EnableAddon(ctx, addon, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableAddon", reflect.TypeOf((*MockClusterClient)(nil).EnableAddon), ctx, addon, options)
 }
 
 // GetAddons mocks base method.
@@ -77,6 +136,21 @@ func (mr *MockClusterClientMockRecorder) GetAddons() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAddons", reflect.TypeOf((*MockClusterClient)(nil).GetAddons))
 }
 
+// GetAttachedNodes mocks base method.
+func (m *MockClusterClient) GetAttachedNodes() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttachedNodes")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAttachedNodes indicates an expected call of GetAttachedNodes.
+func (mr *MockClusterClientMockRecorder) GetAttachedNodes() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttachedNodes", reflect.TypeOf((*MockClusterClient)(nil).GetAttachedNodes))
+}
+
 // GetClusterConfig mocks base method.
 func (m *MockClusterClient) GetClusterConfig() *config.ClusterConfig {
 	m.ctrl.T.Helper()
@@ -119,6 +193,180 @@ func (mr *MockClusterClientMockRecorder) GetK8sVersion() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetK8sVersion", reflect.TypeOf((*MockClusterClient)(nil).GetK8sVersion))
 }
 
+// GetKubeconfig mocks base method.
+func (m *MockClusterClient) GetKubeconfig() (*kubeconfig.Settings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetKubeconfig")
+	ret0, _ := ret[0].(*kubeconfig.Settings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetKubeconfig indicates an expected call of GetKubeconfig.
+func (mr *MockClusterClientMockRecorder) GetKubeconfig() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKubeconfig", reflect.TypeOf((*MockClusterClient)(nil).GetKubeconfig))
+}
+
+// GetKubeconfigPath mocks base method.
+func (m *MockClusterClient) GetKubeconfigPath() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetKubeconfigPath")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// GetKubeconfigPath indicates an expected call of GetKubeconfigPath.
+func (mr *MockClusterClientMockRecorder) GetKubeconfigPath() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKubeconfigPath", reflect.TypeOf((*MockClusterClient)(nil).GetKubeconfigPath))
+}
+
+// GetNodeIPs mocks base method.
+func (m *MockClusterClient) GetNodeIPs(ctx context.Context, cc *config.ClusterConfig) (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNodeIPs", ctx, cc)
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNodeIPs indicates an expected call of GetNodeIPs.
+func (mr *MockClusterClientMockRecorder) GetNodeIPs(ctx, cc interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNodeIPs", reflect.TypeOf((*MockClusterClient)(nil).GetNodeIPs), ctx, cc)
+}
+
+// GetStatus mocks base method.
+func (m *MockClusterClient) GetStatus(ctx context.Context, cc *config.ClusterConfig) ([]*cluster.Status, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStatus", ctx, cc)
+	ret0, _ := ret[0].([]*cluster.Status)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStatus indicates an expected call of GetStatus.
+func (mr *MockClusterClientMockRecorder) GetStatus(ctx, cc interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStatus", reflect.TypeOf((*MockClusterClient)(nil).GetStatus), ctx, cc)
+}
+
+// ListProfiles mocks base method.
+func (m *MockClusterClient) ListProfiles() ([]Profile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProfiles")
+	ret0, _ := ret[0].([]Profile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProfiles indicates an expected call of ListProfiles.
+func (mr *MockClusterClientMockRecorder) ListProfiles() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProfiles", reflect.TypeOf((*MockClusterClient)(nil).ListProfiles))
+}
+
+// LoadClusterConfig mocks base method.
+func (m *MockClusterClient) LoadClusterConfig() (*config.ClusterConfig, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LoadClusterConfig")
+	ret0, _ := ret[0].(*config.ClusterConfig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LoadClusterConfig indicates an expected call of LoadClusterConfig.
+func (mr *MockClusterClientMockRecorder) LoadClusterConfig() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoadClusterConfig", reflect.TypeOf((*MockClusterClient)(nil).LoadClusterConfig))
+}
+
+// Pause mocks base method.
+func (m *MockClusterClient) Pause(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Pause", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Pause indicates an expected call of Pause.
+func (mr *MockClusterClientMockRecorder) Pause(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pause", reflect.TypeOf((*MockClusterClient)(nil).Pause), ctx)
+}
+
+// Purge mocks base method.
+func (m *MockClusterClient) Purge(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Purge", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Purge indicates an expected call of Purge.
+func (mr *MockClusterClientMockRecorder) Purge(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Purge", reflect.TypeOf((*MockClusterClient)(nil).Purge), ctx)
+}
+
+// RemoveNode mocks base method.
+func (m *MockClusterClient) RemoveNode(ctx context.Context, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveNode", ctx, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveNode indicates an expected call of RemoveNode.
+func (mr *MockClusterClientMockRecorder) RemoveNode(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveNode", reflect.TypeOf((*MockClusterClient)(nil).RemoveNode), ctx, name)
+}
+
+// Resize mocks base method.
+func (m *MockClusterClient) Resize(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Resize", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Resize indicates an expected call of Resize.
+func (mr *MockClusterClientMockRecorder) Resize(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resize", reflect.TypeOf((*MockClusterClient)(nil).Resize), ctx)
+}
+
+// Restart mocks base method.
+func (m *MockClusterClient) Restart(ctx context.Context) (*kubeconfig.Settings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restart", ctx)
+	ret0, _ := ret[0].(*kubeconfig.Settings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Restart indicates an expected call of Restart.
+func (mr *MockClusterClientMockRecorder) Restart(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restart", reflect.TypeOf((*MockClusterClient)(nil).Restart), ctx)
+}
+
+// ScaleNodes mocks base method.
+func (m *MockClusterClient) ScaleNodes(ctx context.Context, nodes int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScaleNodes", ctx, nodes)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ScaleNodes indicates an expected call of ScaleNodes.
+func (mr *MockClusterClientMockRecorder) ScaleNodes(ctx, nodes interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScaleNodes", reflect.TypeOf((*MockClusterClient)(nil).ScaleNodes), ctx, nodes)
+}
+
 // SetConfig mocks base method.
 func (m *MockClusterClient) SetConfig(args MinikubeClientConfig) {
 	m.ctrl.T.Helper()
@@ -144,16 +392,44 @@ func (mr *MockClusterClientMockRecorder) SetDependencies(dep interface{}) *gomoc
 }
 
 // Start mocks base method.
-func (m *MockClusterClient) Start() (*kubeconfig.Settings, error) {
+func (m *MockClusterClient) Start(ctx context.Context) (*kubeconfig.Settings, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Start")
+	ret := m.ctrl.Call(m, "Start", ctx)
 	ret0, _ := ret[0].(*kubeconfig.Settings)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Start indicates an expected call of Start.
-func (mr *MockClusterClientMockRecorder) Start() *gomock.Call {
+func (mr *MockClusterClientMockRecorder) Start(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Start", reflect.TypeOf((*MockClusterClient)(nil).Start), ctx)
+}
+
+// Stop mocks base method.
+func (m *MockClusterClient) Stop(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Stop", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Stop indicates an expected call of Stop.
+func (mr *MockClusterClientMockRecorder) Stop(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stop", reflect.TypeOf((*MockClusterClient)(nil).Stop), ctx)
+}
+
+// Unpause mocks base method.
+func (m *MockClusterClient) Unpause(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unpause", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unpause indicates an expected call of Unpause.
+func (mr *MockClusterClientMockRecorder) Unpause(ctx interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Start", reflect.TypeOf((*MockClusterClient)(nil).Start))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unpause", reflect.TypeOf((*MockClusterClient)(nil).Unpause), ctx)
 }
@@ -5,11 +5,13 @@
 package lib
 
 import (
+	context "context"
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
 	libmachine "k8s.io/minikube/pkg/libmachine"
 	host "k8s.io/minikube/pkg/libmachine/host"
+	cluster "k8s.io/minikube/pkg/minikube/cluster"
 	command "k8s.io/minikube/pkg/minikube/command"
 	config "k8s.io/minikube/pkg/minikube/config"
 	kubeconfig "k8s.io/minikube/pkg/minikube/kubeconfig"
@@ -40,47 +42,75 @@ func (m *MockCluster) EXPECT() *MockClusterMockRecorder {
 }
 
 // AddControlPlaneNode mocks base method.
-func (m *MockCluster) AddControlPlaneNode(cc *config.ClusterConfig, k8sVersion string, port int, containerRuntime string) (*config.ClusterConfig, error) {
+func (m *MockCluster) AddControlPlaneNode(ctx context.Context, cc *config.ClusterConfig, k8sVersion string, port int, containerRuntime string) (*config.ClusterConfig, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddControlPlaneNode", cc, k8sVersion, port, containerRuntime)
+	ret := m.ctrl.Call(m, "AddControlPlaneNode", ctx, cc, k8sVersion, port, containerRuntime)
 	ret0, _ := ret[0].(*config.ClusterConfig)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // AddControlPlaneNode indicates an expected call of AddControlPlaneNode.
-func (mr *MockClusterMockRecorder) AddControlPlaneNode(cc, k8sVersion, port, containerRuntime interface{}) *gomock.Call {
+func (mr *MockClusterMockRecorder) AddControlPlaneNode(ctx, cc, k8sVersion, port, containerRuntime interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddControlPlaneNode", reflect.TypeOf((*MockCluster)(nil).AddControlPlaneNode), cc, k8sVersion, port, containerRuntime)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddControlPlaneNode", reflect.TypeOf((*MockCluster)(nil).AddControlPlaneNode), ctx, cc, k8sVersion, port, containerRuntime)
 }
 
 // AddWorkerNode mocks base method.
-func (m *MockCluster) AddWorkerNode(cc *config.ClusterConfig, kv string, apiServerPort int, cr string) error {
+func (m *MockCluster) AddWorkerNode(ctx context.Context, cc *config.ClusterConfig, kv string, apiServerPort int, cr string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddWorkerNode", cc, kv, apiServerPort, cr)
+	ret := m.ctrl.Call(m, "AddWorkerNode", ctx, cc, kv, apiServerPort, cr)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // AddWorkerNode indicates an expected call of AddWorkerNode.
-func (mr *MockClusterMockRecorder) AddWorkerNode(cc, kv, apiServerPort, cr interface{}) *gomock.Call {
+func (mr *MockClusterMockRecorder) AddWorkerNode(ctx, cc, kv, apiServerPort, cr interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWorkerNode", reflect.TypeOf((*MockCluster)(nil).AddWorkerNode), cc, kv, apiServerPort, cr)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWorkerNode", reflect.TypeOf((*MockCluster)(nil).AddWorkerNode), ctx, cc, kv, apiServerPort, cr)
 }
 
 // Delete mocks base method.
-func (m *MockCluster) Delete(cc *config.ClusterConfig, name string) (*config.Node, error) {
+func (m *MockCluster) Delete(ctx context.Context, cc *config.ClusterConfig, name string) (*config.Node, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", cc, name)
+	ret := m.ctrl.Call(m, "Delete", ctx, cc, name)
 	ret0, _ := ret[0].(*config.Node)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Delete indicates an expected call of Delete.
-func (mr *MockClusterMockRecorder) Delete(cc, name interface{}) *gomock.Call {
+func (mr *MockClusterMockRecorder) Delete(ctx, cc, name interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockCluster)(nil).Delete), cc, name)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockCluster)(nil).Delete), ctx, cc, name)
+}
+
+// DeleteNode mocks base method.
+func (m *MockCluster) DeleteNode(ctx context.Context, cc *config.ClusterConfig, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNode", ctx, cc, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteNode indicates an expected call of DeleteNode.
+func (mr *MockClusterMockRecorder) DeleteNode(ctx, cc, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNode", reflect.TypeOf((*MockCluster)(nil).DeleteNode), ctx, cc, name)
+}
+
+// EnableAddon mocks base method.
+func (m *MockCluster) EnableAddon(ctx context.Context, name, addon string, options AddonOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnableAddon", ctx, name, addon, options)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EnableAddon indicates an expected call of EnableAddon.
+func (mr *MockClusterMockRecorder) EnableAddon(ctx, name, addon, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableAddon", reflect.TypeOf((*MockCluster)(nil).EnableAddon), ctx, name, addon, options)
 }
 
 // Get mocks base method.
@@ -97,10 +127,98 @@ func (mr *MockClusterMockRecorder) Get(name interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockCluster)(nil).Get), name)
 }
 
+// Kubeconfig mocks base method.
+func (m *MockCluster) Kubeconfig(name, path string) (*kubeconfig.Settings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Kubeconfig", name, path)
+	ret0, _ := ret[0].(*kubeconfig.Settings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Kubeconfig indicates an expected call of Kubeconfig.
+func (mr *MockClusterMockRecorder) Kubeconfig(name, path interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Kubeconfig", reflect.TypeOf((*MockCluster)(nil).Kubeconfig), name, path)
+}
+
+// Leftovers mocks base method.
+func (m *MockCluster) Leftovers(cc *config.ClusterConfig, name string) []string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Leftovers", cc, name)
+	ret0, _ := ret[0].([]string)
+	return ret0
+}
+
+// Leftovers indicates an expected call of Leftovers.
+func (mr *MockClusterMockRecorder) Leftovers(cc, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Leftovers", reflect.TypeOf((*MockCluster)(nil).Leftovers), cc, name)
+}
+
+// ListProfiles mocks base method.
+func (m *MockCluster) ListProfiles() ([]Profile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProfiles")
+	ret0, _ := ret[0].([]Profile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProfiles indicates an expected call of ListProfiles.
+func (mr *MockClusterMockRecorder) ListProfiles() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProfiles", reflect.TypeOf((*MockCluster)(nil).ListProfiles))
+}
+
+// Load mocks base method.
+func (m *MockCluster) Load(name string) (*config.ClusterConfig, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Load", name)
+	ret0, _ := ret[0].(*config.ClusterConfig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Load indicates an expected call of Load.
+func (mr *MockClusterMockRecorder) Load(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Load", reflect.TypeOf((*MockCluster)(nil).Load), name)
+}
+
+// NodeIPs mocks base method.
+func (m *MockCluster) NodeIPs(ctx context.Context, cc *config.ClusterConfig) (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NodeIPs", ctx, cc)
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NodeIPs indicates an expected call of NodeIPs.
+func (mr *MockClusterMockRecorder) NodeIPs(ctx, cc interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NodeIPs", reflect.TypeOf((*MockCluster)(nil).NodeIPs), ctx, cc)
+}
+
+// Pause mocks base method.
+func (m *MockCluster) Pause(ctx context.Context, cc *config.ClusterConfig) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Pause", ctx, cc)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Pause indicates an expected call of Pause.
+func (mr *MockClusterMockRecorder) Pause(ctx, cc interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pause", reflect.TypeOf((*MockCluster)(nil).Pause), ctx, cc)
+}
+
 // Provision mocks base method.
-func (m *MockCluster) Provision(cc *config.ClusterConfig, n *config.Node, delOnFail bool) (command.Runner, bool, libmachine.API, *host.Host, error) {
+func (m *MockCluster) Provision(ctx context.Context, cc *config.ClusterConfig, n *config.Node, delOnFail bool) (command.Runner, bool, libmachine.API, *host.Host, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Provision", cc, n, delOnFail)
+	ret := m.ctrl.Call(m, "Provision", ctx, cc, n, delOnFail)
 	ret0, _ := ret[0].(command.Runner)
 	ret1, _ := ret[1].(bool)
 	ret2, _ := ret[2].(libmachine.API)
@@ -110,36 +228,107 @@ func (m *MockCluster) Provision(cc *config.ClusterConfig, n *config.Node, delOnF
 }
 
 // Provision indicates an expected call of Provision.
-func (mr *MockClusterMockRecorder) Provision(cc, n, delOnFail interface{}) *gomock.Call {
+func (mr *MockClusterMockRecorder) Provision(ctx, cc, n, delOnFail interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Provision", reflect.TypeOf((*MockCluster)(nil).Provision), ctx, cc, n, delOnFail)
+}
+
+// Resize mocks base method.
+func (m *MockCluster) Resize(ctx context.Context, cc *config.ClusterConfig, cpus, memory int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Resize", ctx, cc, cpus, memory)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Resize indicates an expected call of Resize.
+func (mr *MockClusterMockRecorder) Resize(ctx, cc, cpus, memory interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Provision", reflect.TypeOf((*MockCluster)(nil).Provision), cc, n, delOnFail)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resize", reflect.TypeOf((*MockCluster)(nil).Resize), ctx, cc, cpus, memory)
 }
 
 // SetAddon mocks base method.
-func (m *MockCluster) SetAddon(name, addon, value string) error {
+func (m *MockCluster) SetAddon(ctx context.Context, name, addon, value string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetAddon", name, addon, value)
+	ret := m.ctrl.Call(m, "SetAddon", ctx, name, addon, value)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // SetAddon indicates an expected call of SetAddon.
-func (mr *MockClusterMockRecorder) SetAddon(name, addon, value interface{}) *gomock.Call {
+func (mr *MockClusterMockRecorder) SetAddon(ctx, name, addon, value interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAddon", reflect.TypeOf((*MockCluster)(nil).SetAddon), name, addon, value)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAddon", reflect.TypeOf((*MockCluster)(nil).SetAddon), ctx, name, addon, value)
 }
 
 // Start mocks base method.
-func (m *MockCluster) Start(starter node.Starter) (*kubeconfig.Settings, error) {
+func (m *MockCluster) Start(ctx context.Context, starter node.Starter) (*kubeconfig.Settings, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Start", starter)
+	ret := m.ctrl.Call(m, "Start", ctx, starter)
 	ret0, _ := ret[0].(*kubeconfig.Settings)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Start indicates an expected call of Start.
-func (mr *MockClusterMockRecorder) Start(starter interface{}) *gomock.Call {
+func (mr *MockClusterMockRecorder) Start(ctx, starter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Start", reflect.TypeOf((*MockCluster)(nil).Start), ctx, starter)
+}
+
+// StartNode mocks base method.
+func (m *MockCluster) StartNode(ctx context.Context, cc *config.ClusterConfig, n config.Node) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartNode", ctx, cc, n)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StartNode indicates an expected call of StartNode.
+func (mr *MockClusterMockRecorder) StartNode(ctx, cc, n interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartNode", reflect.TypeOf((*MockCluster)(nil).StartNode), ctx, cc, n)
+}
+
+// Status mocks base method.
+func (m *MockCluster) Status(ctx context.Context, cc *config.ClusterConfig) ([]*cluster.Status, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Status", ctx, cc)
+	ret0, _ := ret[0].([]*cluster.Status)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Status indicates an expected call of Status.
+func (mr *MockClusterMockRecorder) Status(ctx, cc interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Status", reflect.TypeOf((*MockCluster)(nil).Status), ctx, cc)
+}
+
+// Stop mocks base method.
+func (m *MockCluster) Stop(ctx context.Context, cc *config.ClusterConfig) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Stop", ctx, cc)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Stop indicates an expected call of Stop.
+func (mr *MockClusterMockRecorder) Stop(ctx, cc interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stop", reflect.TypeOf((*MockCluster)(nil).Stop), ctx, cc)
+}
+
+// Unpause mocks base method.
+func (m *MockCluster) Unpause(ctx context.Context, cc *config.ClusterConfig) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unpause", ctx, cc)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unpause indicates an expected call of Unpause.
+func (mr *MockClusterMockRecorder) Unpause(ctx, cc interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Start", reflect.TypeOf((*MockCluster)(nil).Start), starter)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unpause", reflect.TypeOf((*MockCluster)(nil).Unpause), ctx, cc)
 }
@@ -5,9 +5,11 @@
 package lib
 
 import (
+	context "context"
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
+	config "k8s.io/minikube/pkg/minikube/config"
 )
 
 // MockDownloader is a mock of Downloader interface.
@@ -34,30 +36,44 @@ func (m *MockDownloader) EXPECT() *MockDownloaderMockRecorder {
 }
 
 // ISO mocks base method.
-func (m *MockDownloader) ISO(urls []string, skipChecksum bool) (string, error) {
+func (m *MockDownloader) ISO(ctx context.Context, urls []string, skipChecksum bool) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ISO", urls, skipChecksum)
+	ret := m.ctrl.Call(m, "ISO", ctx, urls, skipChecksum)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ISO indicates an expected call of ISO.
-func (mr *MockDownloaderMockRecorder) ISO(urls, skipChecksum interface{}) *gomock.Call {
+func (mr *MockDownloaderMockRecorder) ISO(ctx, urls, skipChecksum interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ISO", reflect.TypeOf((*MockDownloader)(nil).ISO), urls, skipChecksum)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ISO", reflect.TypeOf((*MockDownloader)(nil).ISO), ctx, urls, skipChecksum)
 }
 
 // PreloadTarball mocks base method.
-func (m *MockDownloader) PreloadTarball(k8sVersion, containerRuntime, driver string) error {
+func (m *MockDownloader) PreloadTarball(ctx context.Context, k8sVersion, containerRuntime, driver string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "PreloadTarball", k8sVersion, containerRuntime, driver)
+	ret := m.ctrl.Call(m, "PreloadTarball", ctx, k8sVersion, containerRuntime, driver)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // PreloadTarball indicates an expected call of PreloadTarball.
-func (mr *MockDownloaderMockRecorder) PreloadTarball(k8sVersion, containerRuntime, driver interface{}) *gomock.Call {
+func (mr *MockDownloaderMockRecorder) PreloadTarball(ctx, k8sVersion, containerRuntime, driver interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreloadTarball", reflect.TypeOf((*MockDownloader)(nil).PreloadTarball), k8sVersion, containerRuntime, driver)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreloadTarball", reflect.TypeOf((*MockDownloader)(nil).PreloadTarball), ctx, k8sVersion, containerRuntime, driver)
+}
+
+// Purge mocks base method.
+func (m *MockDownloader) Purge(ctx context.Context, cc *config.ClusterConfig) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Purge", ctx, cc)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Purge indicates an expected call of Purge.
+func (mr *MockDownloaderMockRecorder) Purge(ctx, cc interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Purge", reflect.TypeOf((*MockDownloader)(nil).Purge), ctx, cc)
 }
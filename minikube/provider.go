@@ -3,10 +3,12 @@ package minikube
 import (
 	"context"
 	"sync"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/scott-the-programmer/terraform-provider-minikube/minikube/lib"
+	"github.com/scott-the-programmer/terraform-provider-minikube/minikube/state_utils"
 )
 
 func init() {
@@ -21,16 +23,44 @@ func NewProvider(providerConfigure schema.ConfigureContextFunc) *schema.Provider
 	return &schema.Provider{
 		ResourcesMap: map[string]*schema.Resource{
 			"minikube_cluster": ResourceCluster(),
+			"minikube_addon":   ResourceAddon(),
+			"minikube_node":    ResourceNode(),
+		},
+		DataSourcesMap: map[string]*schema.Resource{
+			"minikube_cluster":  DataSourceCluster(),
+			"minikube_profiles": DataSourceProfiles(),
 		},
-		DataSourcesMap:       map[string]*schema.Resource{},
 		ConfigureContextFunc: providerConfigure,
 		Schema: map[string]*schema.Schema{
 			"kubernetes_version": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				Description: "The Kubernetes version that the minikube VM will use. Defaults to 'v1.30.0'.",
+				Description: "The default Kubernetes version for clusters that do not set their own kubernetes_version. Defaults to 'v1.30.0'.",
 				Default:     "v1.30.0",
 			},
+			"kubeconfig_path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The kubeconfig file clusters write their context to, unless they set their own kubeconfig_path. Defaults to KUBECONFIG or ~/.kube/config.",
+			},
+			"execution_mode": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "How cluster operations are run, either in_process or subprocess. in_process changes one cluster at a time, as minikube's global state is shared by the whole provider, though refreshes still run in parallel. It still runs the operations that provision machines, i.e. creating, restarting and scaling clusters and adding nodes, in a helper process. subprocess runs every operation in its own helper process, so that operations on different clusters run concurrently. Where minikube exits a helper on a fatal error, the reason and advice are reported as a diagnostic. Defaults to in_process.",
+				Default:          lib.ExecutionModeInProcess,
+				ValidateDiagFunc: state_utils.ExecutionModeValidator(),
+			},
+			"lock_timeout": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "How many minutes to wait for another terraform run to release a cluster's profile or the download cache before giving up. The error then names the process holding the lock. 0 gives up straight away. Only terraform runs take these locks: they do not keep out the minikube CLI, so do not run it against a cluster terraform is changing. Defaults to 10.",
+				Default:     10,
+			},
+			"minikube_home": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The MINIKUBE_HOME that clusters, their profiles and the download cache are kept in, along with the driver binaries under its bin directory. Set a different home per provider alias or workspace to keep their clusters apart. Defaults to MINIKUBE_HOME or ~/.minikube.",
+			},
 		},
 	}
 }
@@ -38,12 +68,37 @@ func NewProvider(providerConfigure schema.ConfigureContextFunc) *schema.Provider
 func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
-	mutex := &sync.Mutex{}
+	mutex := &sync.RWMutex{}
 	k8sVersion := d.Get("kubernetes_version").(string)
+	kubeconfigPath := d.Get("kubeconfig_path").(string)
+	executionMode := d.Get("execution_mode").(string)
+	lockTimeout := time.Duration(d.Get("lock_timeout").(int)) * time.Minute
+
+	// Each provider configuration runs in its own plugin process, so aliases can use different homes
+	err := lib.UseMinikubeHome(d.Get("minikube_home").(string))
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+
 	minikubeClientFactory := func() (lib.ClusterClient, error) {
-		return &lib.MinikubeClient{
-			TfCreationLock: mutex,
-			K8sVersion:     k8sVersion}, nil
+		if executionMode == lib.ExecutionModeSubprocess {
+			client, err := lib.NewIsolatedClient(k8sVersion, kubeconfigPath)
+			if err != nil {
+				return nil, err
+			}
+			client.LockTimeout = lockTimeout
+			return client, nil
+		}
+
+		client, err := lib.NewGuardedClient(&lib.MinikubeClient{
+			TfCreationLock:        mutex,
+			K8sVersion:            k8sVersion,
+			DefaultKubeconfigPath: kubeconfigPath,
+			LockTimeout:           lockTimeout})
+		if err != nil {
+			return nil, err
+		}
+		return client, nil
 	}
 	return minikubeClientFactory, diags
 }
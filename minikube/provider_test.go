@@ -2,6 +2,9 @@ package minikube
 
 import (
 	"context"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -28,16 +31,75 @@ func TestProvider_bootstrap(t *testing.T) {
 			Description: "The Kubernetes version that the minikube VM will use. Defaults to 'stable'.",
 			Default:     "v99.99.99",
 		},
+		"kubeconfig_path": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"execution_mode": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Default:  lib.ExecutionModeInProcess,
+		},
+		"lock_timeout": {
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  10,
+		},
+		"minikube_home": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
 	}
 
-	rawC := make(map[string]interface{})
+	rawC := map[string]interface{}{
+		"kubeconfig_path": "/tmp/kubeconfig",
+	}
 
 	data := schema.TestResourceDataRaw(t, sch, rawC)
 
 	m, _ := provider.ConfigureContextFunc(context.TODO(), data)
 
 	clusterClientFactory := m.(func() (lib.ClusterClient, error))
-	_, err := clusterClientFactory()
+	client, err := clusterClientFactory()
 
 	assert.NoError(t, err)
+	assert.IsType(t, &lib.GuardedClient{}, client)
+	assert.Equal(t, "/tmp/kubeconfig", client.GetKubeconfigPath())
+}
+
+func TestProvider_subprocess(t *testing.T) {
+	provider := Provider()
+
+	data := schema.TestResourceDataRaw(t, provider.Schema, map[string]interface{}{
+		"execution_mode":  lib.ExecutionModeSubprocess,
+		"kubeconfig_path": "/tmp/kubeconfig",
+	})
+
+	m, diags := provider.ConfigureContextFunc(context.TODO(), data)
+	assert.False(t, diags.HasError())
+
+	clusterClientFactory := m.(func() (lib.ClusterClient, error))
+	client, err := clusterClientFactory()
+
+	assert.NoError(t, err)
+	assert.IsType(t, &lib.IsolatedClient{}, client)
+	assert.Equal(t, "/tmp/kubeconfig", client.GetKubeconfigPath())
+}
+
+func TestProvider_minikubeHome(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("MINIKUBE_HOME", "")
+	t.Setenv("PATH", os.Getenv("PATH"))
+
+	provider := Provider()
+
+	data := schema.TestResourceDataRaw(t, provider.Schema, map[string]interface{}{
+		"minikube_home": home,
+	})
+
+	_, diags := provider.ConfigureContextFunc(context.TODO(), data)
+	assert.False(t, diags.HasError())
+
+	assert.Equal(t, home, os.Getenv("MINIKUBE_HOME"))
+	assert.True(t, strings.HasPrefix(os.Getenv("PATH"), filepath.Join(home, ".minikube", "bin")))
 }
@@ -0,0 +1,230 @@
+package minikube
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/scott-the-programmer/terraform-provider-minikube/minikube/lib"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceAddon() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Used to enable a single addon on an existing minikube cluster, independently of the cluster's lifecycle",
+		CreateContext: resourceAddonCreate,
+		ReadContext:   resourceAddonRead,
+		DeleteContext: resourceAddonDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceAddonImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"cluster_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the minikube cluster to enable the addon on",
+			},
+
+			"addon": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the addon. see `minikube addons list` for a list of valid addon names.",
+			},
+
+			"images": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Images used by the addon, keyed by image name. Equivalent to `minikube addons enable --images`",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"registries": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Registries used by the addon, keyed by image name. Equivalent to `minikube addons enable --registries`",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"metallb": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				MaxItems:    1,
+				Description: "The load balancer address range handed out by the metallb addon",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"start_ip": {
+							Type:        schema.TypeString,
+							Required:    true,
+							ForceNew:    true,
+							Description: "The first IP address of the load balancer range",
+						},
+						"end_ip": {
+							Type:        schema.TypeString,
+							Required:    true,
+							ForceNew:    true,
+							Description: "The last IP address of the load balancer range",
+						},
+					},
+				},
+			},
+
+			"registry_creds": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				MaxItems:    1,
+				Description: "The private docker registry credentials used by the registry-creds addon",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"docker_server": {
+							Type:        schema.TypeString,
+							Required:    true,
+							ForceNew:    true,
+							Description: "The docker registry server",
+						},
+						"docker_username": {
+							Type:        schema.TypeString,
+							Required:    true,
+							ForceNew:    true,
+							Description: "The docker registry username",
+						},
+						"docker_password": {
+							Type:        schema.TypeString,
+							Required:    true,
+							ForceNew:    true,
+							Sensitive:   true,
+							Description: "The docker registry password",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAddonCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clusterName := d.Get("cluster_name").(string)
+	addon := d.Get("addon").(string)
+
+	client, err := initialiseExistingClusterClient(m, clusterName)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	err = client.EnableAddon(ctx, addon, getAddonOptions(d))
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	d.SetId(addonId(clusterName, addon))
+
+	return resourceAddonRead(ctx, d, m)
+}
+
+func resourceAddonRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	client, err := initialiseExistingClusterClient(m, d.Get("cluster_name").(string))
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	cc, err := client.LoadClusterConfig()
+	if errors.Is(err, lib.ErrClusterNotFound) {
+		// The cluster was deleted outside of terraform, taking the addon with it
+		d.SetId("")
+		return diags
+	}
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	if !cc.Addons[d.Get("addon").(string)] {
+		// The addon was disabled outside of terraform
+		d.SetId("")
+	}
+
+	return diags
+}
+
+func resourceAddonDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	client, err := initialiseExistingClusterClient(m, d.Get("cluster_name").(string))
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	err = client.DisableAddon(ctx, d.Get("addon").(string))
+	if err != nil && !errors.Is(err, lib.ErrClusterNotFound) {
+		return diagFromErr(err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+func resourceAddonImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	clusterName, addon, ok := strings.Cut(d.Id(), "/")
+	if !ok || clusterName == "" || addon == "" {
+		return nil, fmt.Errorf("unexpected addon id %q, expected <cluster_name>/<addon>", d.Id())
+	}
+
+	d.Set("cluster_name", clusterName)
+	d.Set("addon", addon)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func addonId(clusterName string, addon string) string {
+	return fmt.Sprintf("%s/%s", clusterName, addon)
+}
+
+// getAddonOptions reads the addon specific settings from the resource
+func getAddonOptions(d *schema.ResourceData) lib.AddonOptions {
+	options := lib.AddonOptions{
+		Images:     expandStringMap(d.Get("images").(map[string]interface{})),
+		Registries: expandStringMap(d.Get("registries").(map[string]interface{})),
+	}
+
+	if v, ok := d.GetOk("metallb"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		metallb := v.([]interface{})[0].(map[string]interface{})
+		options.MetalLB = &lib.MetalLBOptions{
+			StartIP: metallb["start_ip"].(string),
+			EndIP:   metallb["end_ip"].(string),
+		}
+	}
+
+	if v, ok := d.GetOk("registry_creds"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		creds := v.([]interface{})[0].(map[string]interface{})
+		options.RegistryCreds = &lib.RegistryCredsOptions{
+			DockerServer:   creds["docker_server"].(string),
+			DockerUsername: creds["docker_username"].(string),
+			DockerPassword: creds["docker_password"].(string),
+		}
+	}
+
+	return options
+}
+
+func expandStringMap(m map[string]interface{}) map[string]string {
+	expanded := make(map[string]string, len(m))
+	for k, v := range m {
+		expanded[k] = v.(string)
+	}
+
+	return expanded
+}
@@ -0,0 +1,203 @@
+package minikube
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/scott-the-programmer/terraform-provider-minikube/minikube/lib"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"k8s.io/minikube/pkg/minikube/config"
+)
+
+func TestAddonCreation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  map[string]*schema.Provider{"minikube": NewProvider(mockAddon(t, "TestAddonCreation"))},
+		Steps: []resource.TestStep{
+			{
+				Config: testUnitAddonConfig("TestAddonCreation"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("minikube_addon.metallb", "id", "TestAddonCreation/metallb"),
+					resource.TestCheckResourceAttr("minikube_addon.metallb", "metallb.0.start_ip", "192.168.49.100"),
+				),
+			},
+		},
+	})
+}
+
+func TestAddonRead(t *testing.T) {
+	tests := []struct {
+		name    string
+		cc      *config.ClusterConfig
+		err     error
+		wantId  string
+		wantErr bool
+	}{
+		{
+			name:   "Enabled",
+			cc:     &config.ClusterConfig{Addons: map[string]bool{"metallb": true}},
+			wantId: "TestAddonRead/metallb",
+		},
+		{
+			name:   "Disabled Outside Terraform",
+			cc:     &config.ClusterConfig{Addons: map[string]bool{"metallb": false}},
+			wantId: "",
+		},
+		{
+			name:   "Cluster Deleted Outside Terraform",
+			err:    fmt.Errorf("%w: TestAddonRead", lib.ErrClusterNotFound),
+			wantId: "",
+		},
+		{
+			name:    "Unreadable Profile",
+			err:     errors.New("unexpected end of JSON input"),
+			wantId:  "TestAddonRead/metallb",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockClusterClient := lib.NewMockClusterClient(ctrl)
+
+			mockClusterClient.EXPECT().
+				SetConfig(gomock.Any()).
+				AnyTimes()
+
+			mockClusterClient.EXPECT().
+				SetDependencies(gomock.Any()).
+				AnyTimes()
+
+			mockClusterClient.EXPECT().
+				LoadClusterConfig().
+				Return(tt.cc, tt.err)
+
+			mockClusterClientFactory := func() (lib.ClusterClient, error) {
+				return mockClusterClient, nil
+			}
+
+			d := schema.TestResourceDataRaw(t, ResourceAddon().Schema, map[string]interface{}{
+				"cluster_name": "TestAddonRead",
+				"addon":        "metallb",
+			})
+			d.SetId("TestAddonRead/metallb")
+
+			diags := resourceAddonRead(context.Background(), d, mockClusterClientFactory)
+			if diags.HasError() != tt.wantErr {
+				t.Fatalf("resourceAddonRead() diags = %v, wantErr %v", diags, tt.wantErr)
+			}
+
+			if d.Id() != tt.wantId {
+				t.Errorf("resourceAddonRead() id = %q, want %q", d.Id(), tt.wantId)
+			}
+		})
+	}
+}
+
+func TestAddonDeleteClusterDeleted(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockClusterClient := lib.NewMockClusterClient(ctrl)
+
+	mockClusterClient.EXPECT().
+		SetConfig(gomock.Any()).
+		AnyTimes()
+
+	mockClusterClient.EXPECT().
+		SetDependencies(gomock.Any()).
+		AnyTimes()
+
+	mockClusterClient.EXPECT().
+		DisableAddon(gomock.Any(), "metallb").
+		Return(fmt.Errorf("%w: TestAddonDeleteClusterDeleted", lib.ErrClusterNotFound))
+
+	mockClusterClientFactory := func() (lib.ClusterClient, error) {
+		return mockClusterClient, nil
+	}
+
+	d := schema.TestResourceDataRaw(t, ResourceAddon().Schema, map[string]interface{}{
+		"cluster_name": "TestAddonDeleteClusterDeleted",
+		"addon":        "metallb",
+	})
+	d.SetId("TestAddonDeleteClusterDeleted/metallb")
+
+	diags := resourceAddonDelete(context.Background(), d, mockClusterClientFactory)
+	if diags.HasError() {
+		t.Fatalf("resourceAddonDelete() returned errors: %v", diags)
+	}
+
+	if d.Id() != "" {
+		t.Errorf("resourceAddonDelete() id = %q, want it removed from state", d.Id())
+	}
+}
+
+func mockAddon(t *testing.T, clusterName string) schema.ConfigureContextFunc {
+	ctrl := gomock.NewController(t)
+	mockClusterClient := lib.NewMockClusterClient(ctrl)
+
+	mockClusterClient.EXPECT().
+		SetConfig(lib.MinikubeClientConfig{ClusterName: clusterName}).
+		AnyTimes()
+
+	mockClusterClient.EXPECT().
+		SetDependencies(gomock.Any()).
+		AnyTimes()
+
+	mockClusterClient.EXPECT().
+		EnableAddon(gomock.Any(), "metallb", lib.AddonOptions{
+			Images:     map[string]string{"Speaker": "quay.io/metallb/speaker:v0.9.6"},
+			Registries: map[string]string{},
+			MetalLB: &lib.MetalLBOptions{
+				StartIP: "192.168.49.100",
+				EndIP:   "192.168.49.120",
+			},
+		}).
+		Return(nil).
+		Times(1)
+
+	mockClusterClient.EXPECT().
+		LoadClusterConfig().
+		Return(&config.ClusterConfig{
+			Name:   clusterName,
+			Addons: map[string]bool{"default-storageclass": true, "metallb": true, "storage-provisioner": true},
+		}, nil).
+		AnyTimes()
+
+	mockClusterClient.EXPECT().
+		DisableAddon(gomock.Any(), "metallb").
+		Return(nil).
+		Times(1)
+
+	configureContext := func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+		var diags diag.Diagnostics
+		mockClusterClientFactory := func() (lib.ClusterClient, error) {
+			return mockClusterClient, nil
+		}
+		return mockClusterClientFactory, diags
+	}
+
+	return configureContext
+}
+
+func testUnitAddonConfig(clusterName string) string {
+	return fmt.Sprintf(`
+	resource "minikube_addon" "metallb" {
+		cluster_name = "%s"
+		addon = "metallb"
+
+		images = {
+			Speaker = "quay.io/metallb/speaker:v0.9.6"
+		}
+
+		metallb {
+			start_ip = "192.168.49.100"
+			end_ip = "192.168.49.120"
+		}
+	}
+	`, clusterName)
+}
@@ -7,13 +7,17 @@ import (
 	"net"
 	"sort"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/scott-the-programmer/terraform-provider-minikube/minikube/lib"
 	"github.com/scott-the-programmer/terraform-provider-minikube/minikube/state_utils"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"k8s.io/minikube/pkg/minikube/cluster"
 	"k8s.io/minikube/pkg/minikube/config"
 	"k8s.io/minikube/pkg/minikube/kubeconfig"
 	pkgutil "k8s.io/minikube/pkg/util"
@@ -23,6 +27,18 @@ var (
 	defaultIso = lib.GetMinikubeIso()
 )
 
+// restartFields are applied by re-running start against the existing cluster, which persists the new config
+// and re-runs the bootstrapper. This regenerates the kubeadm config and apiserver certificates, keeping workloads and images
+var restartFields = []string{
+	"kubernetes_version",
+	"extra_config",
+	"feature_gates",
+	"apiserver_names",
+	"apiserver_ips",
+	"registry_mirror",
+	"kubeconfig_path",
+}
+
 func ResourceCluster() *schema.Resource {
 	return &schema.Resource{
 		Description:   "Used to create a minikube cluster on the current host",
@@ -30,9 +46,22 @@ func ResourceCluster() *schema.Resource {
 		ReadContext:   resourceClusterRead,
 		DeleteContext: resourceClusterDelete,
 		UpdateContext: resourceClusterUpdate,
-		Schema:        GetClusterSchema(),
+		CustomizeDiff: customdiff.All(
+			validateKubernetesVersionChange,
+			ensureClusterRunning,
+			validateStoppedClusterChanges,
+			forceNewOnUnsupportedResize,
+		),
+		Schema: GetClusterSchema(),
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceClusterImport,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create:  schema.DefaultTimeout(60 * time.Minute),
+			Read:    schema.DefaultTimeout(10 * time.Minute),
+			Update:  schema.DefaultTimeout(60 * time.Minute),
+			Delete:  schema.DefaultTimeout(20 * time.Minute),
+			Default: schema.DefaultTimeout(20 * time.Minute),
 		},
 	}
 }
@@ -42,24 +71,38 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, m interf
 
 	client, err := initialiseMinikubeClient(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
-	kc, err := client.Start()
+
+	previousContext, err := previousKubeconfigContext(client, d.Get("cluster_name").(string))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
-	key, certificate, ca, address, err := getClusterOutputs(kc)
+	kc, err := client.Start(ctx)
+	if err != nil {
+		// Unless delete_on_failure cleaned it up, an interrupted create leaves a partial cluster behind.
+		// Track it so that it is tainted and replaced by the next apply
+		if ctx.Err() != nil && !d.Get("delete_on_failure").(bool) {
+			d.SetId(d.Get("cluster_name").(string))
+		}
+		return diagFromErr(err)
+	}
+	d.Set("kubeconfig_previous_context", previousContext)
+
+	err = setClusterOutputs(d, kc)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	d.SetId(d.Get("cluster_name").(string))
-	d.Set("client_key", key)
-	d.Set("client_certificate", certificate)
-	d.Set("cluster_ca_certificate", ca)
-	d.Set("host", address)
-	d.Set("cluster_name", kc.ClusterName)
+
+	if state, ok := d.GetOk("state"); ok {
+		err = transitionClusterState(ctx, client, lib.StateRunning, state.(string))
+		if err != nil {
+			return diagFromErr(err)
+		}
+	}
 
 	diags = resourceClusterRead(ctx, d, m)
 
@@ -70,7 +113,56 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, m interf
 	var diags diag.Diagnostics
 	client, err := initialiseMinikubeClient(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
+	}
+
+	// Machines are resized while stopped, and pick up their new resources when started again
+	resize := d.HasChanges("cpus", "memory")
+	if resize {
+		err = client.Resize(ctx)
+		if err != nil {
+			return diagFromErr(err)
+		}
+	}
+
+	// A stopped cluster is started first, so that the remaining changes can be applied to it
+	fromState, toState := d.GetChange("state")
+	restart := resize || d.HasChanges(restartFields...) || (d.HasChange("state") && fromState.(string) == lib.StateStopped)
+	if restart {
+		previousContext := d.Get("kubeconfig_previous_context").(string)
+		if d.HasChange("kubeconfig_path") {
+			previousContext, err = previousKubeconfigContext(client, d.Get("cluster_name").(string))
+			if err != nil {
+				return diagFromErr(err)
+			}
+		}
+
+		kc, err := client.Restart(ctx)
+		if err != nil {
+			return diagFromErr(err)
+		}
+
+		err = setClusterOutputs(d, kc)
+		if err != nil {
+			return diagFromErr(err)
+		}
+
+		// The context now lives in the new kubeconfig, so remove it from the old one
+		oldPath, _ := d.GetChange("kubeconfig_path")
+		if d.HasChange("kubeconfig_path") && oldPath.(string) != "" {
+			err = removeKubeconfigContext(oldPath.(string), d.Get("cluster_name").(string), d.Get("kubeconfig_previous_context").(string))
+			if err != nil {
+				return diagFromErr(err)
+			}
+		}
+		d.Set("kubeconfig_previous_context", previousContext)
+	}
+
+	if d.HasChange("nodes") {
+		err = client.ScaleNodes(ctx, d.Get("nodes").(int))
+		if err != nil {
+			return diagFromErr(err)
+		}
 	}
 
 	if d.HasChange("addons") {
@@ -79,18 +171,13 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, m interf
 		oldAddonStrings := state_utils.SetToSlice(oldAddons.(*schema.Set))
 		newAddonStrings := state_utils.SetToSlice(newAddons.(*schema.Set))
 
-		client.SetConfig(lib.MinikubeClientConfig{
-			ClusterConfig:   config.ClusterConfig,
-			IsoUrls:         config.IsoUrls,
-			ClusterName:     config.ClusterName,
-			Addons:          oldAddonStrings,
-			DeleteOnFailure: config.DeleteOnFailure,
-			Nodes:           config.Nodes,
-		})
+		// Diff against the addons currently applied, keeping the rest of the config as built for this cluster
+		config.Addons = oldAddonStrings
+		client.SetConfig(config)
 
-		err = client.ApplyAddons(newAddonStrings)
+		err = client.ApplyAddons(ctx, newAddonStrings)
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 
 		sort.Strings(newAddonStrings) //to ensure consistency with TF state
@@ -98,24 +185,93 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, m interf
 		d.Set("addons", newAddonStrings)
 	}
 
+	if d.HasChange("state") || restart {
+		from := fromState.(string)
+		if restart {
+			from = lib.StateRunning
+		}
+
+		err = transitionClusterState(ctx, client, from, toState.(string))
+		if err != nil {
+			return diagFromErr(err)
+		}
+	}
+
 	return diags
 }
 
-func resourceClusterDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	var diags diag.Diagnostics
+// destroyRetryError retries the transient failures of a destroy, but gives up straight away on the ones that
+// retrying cannot fix: another run holding the lock past lock_timeout, or a cancelled operation
+func destroyRetryError(err error) *retry.RetryError {
+	var lockErr *lib.LockTimeoutError
+	if errors.As(err, &lockErr) ||
+		errors.Is(err, context.Canceled) ||
+		errors.Is(err, context.DeadlineExceeded) {
+		return retry.NonRetryableError(err)
+	}
+
+	return retry.RetryableError(err)
+}
+
+// transitionClusterState stops, pauses or resumes a running or paused cluster. Stopped clusters are started through Restart
+func transitionClusterState(ctx context.Context, client lib.ClusterClient, from string, to string) error {
+	if from == to {
+		return nil
+	}
+
+	switch to {
+	case lib.StateStopped:
+		return client.Stop(ctx)
+	case lib.StatePaused:
+		return client.Pause(ctx)
+	case lib.StateRunning:
+		if from == lib.StatePaused {
+			return client.Unpause(ctx)
+		}
+	}
 
+	return nil
+}
+
+func resourceClusterDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client, err := initialiseMinikubeClient(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
-	err = client.Delete()
+
+	destroy := client.Delete
+	switch d.Get("destroy_mode").(string) {
+	case lib.DestroyModeStop:
+		destroy = client.Stop
+	case lib.DestroyModePurge:
+		destroy = client.Purge
+	}
+
+	// Driver commands can fail transiently, e.g. while a container is still being torn down, so retry with backoff
+	// rather than leaving a machine running that terraform no longer knows about. Retries last for the delete timeout,
+	// which should stay longer than lock_timeout so that an attempt waiting for another run's lock is not cut short
+	err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *retry.RetryError {
+		err := destroy(ctx)
+		if errors.Is(err, lib.ErrClusterNotFound) {
+			// Deleted out of band, e.g. with minikube delete, so there is nothing left to stop or delete
+			return nil
+		}
+		if err != nil {
+			return destroyRetryError(err)
+		}
+		return nil
+	})
 	if err != nil {
-		fmt.Printf("Failed to delete cluster - you might want to consider running `minikube delete -p %s`", d.Get("cluster_name").(string))
+		diags := diagFromErr(err)
+		diags[0].Detail = strings.TrimSpace(fmt.Sprintf(
+			"%s\n\nThe cluster was kept in state so the next destroy tries again. You might want to consider running `minikube delete -p %s`",
+			diags[0].Detail, d.Get("cluster_name").(string)))
+		return diags
 	}
 
 	d.SetId("")
 
-	return diags
+	return nil
 }
 
 func resourceClusterRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -123,12 +279,60 @@ func resourceClusterRead(ctx context.Context, d *schema.ResourceData, m interfac
 
 	client, err := initialiseMinikubeClient(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
+	}
+	cc, err := client.LoadClusterConfig()
+	if errors.Is(err, lib.ErrClusterNotFound) {
+		// The cluster was deleted outside of terraform, e.g. with `minikube delete`
+		d.SetId("")
+		return append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("minikube cluster %s no longer exists", d.Get("cluster_name").(string)),
+			Detail:   "The cluster was removed outside of terraform and will be recreated on the next apply",
+		})
+	}
+	if err != nil {
+		return diagFromErr(err)
 	}
-	cc := client.GetClusterConfig()
-	tfc := client.GetConfig()
-	addons := client.GetAddons()
-	sort.Strings(addons) //to ensure consistency with TF state
+
+	// Derive the node topology from the machines that actually exist, so that nodes added or removed by hand are noticed
+	statuses, err := client.GetStatus(ctx, cc)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("could not retrieve the machine state of cluster %s", d.Get("cluster_name").(string)),
+			Detail:   fmt.Sprintf("The nodes are read from the minikube profile only: %v", err),
+		})
+	}
+	nodes := lib.ExistingNodes(cc, statuses)
+	if err == nil {
+		d.Set("state", lib.SummariseStatus(statuses))
+		d.Set("health", lib.SummariseHealth(statuses))
+		d.Set("status", flattenStatuses(statuses))
+	}
+
+	ips, err := client.GetNodeIPs(ctx, cc)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("could not look up the node addresses of cluster %s", d.Get("cluster_name").(string)),
+			Detail:   fmt.Sprintf("The addresses are read from the minikube profile only: %v", err),
+		})
+	}
+	d.Set("node", flattenNodeInventory(cc, nodes, ips))
+	d.Set("kubeconfig_path", client.GetKubeconfigPath())
+
+	// Only count the nodes managed by this resource, so that nodes attached by minikube_node are left alone,
+	// the same way ScaleNodes counts them
+	attached, err := client.GetAttachedNodes()
+	if err != nil {
+		return append(diags, diagFromErr(err)...)
+	}
+
+	// Only track the addons managed by this resource, so that addons enabled elsewhere
+	// (e.g. by minikube_addon) are left alone
+	managedAddons := state_utils.SetToSlice(d.Get("addons").(*schema.Set))
+	addons := state_utils.Intersection(client.GetAddons(), managedAddons)
 
 	stringPorts := cc.ExposedPorts
 	ports := make([]int, len(stringPorts))
@@ -137,12 +341,12 @@ func resourceClusterRead(ctx context.Context, d *schema.ResourceData, m interfac
 		ports[i] = p
 	}
 
-	setClusterState(d, cc, tfc, ports, addons)
+	setClusterState(d, cc, lib.OwnedNodes(nodes, attached), ports, addons)
 
 	return diags
 }
 
-func setClusterState(d *schema.ResourceData, cc *config.ClusterConfig, tfc lib.MinikubeClientConfig, ports []int, addons []string) {
+func setClusterState(d *schema.ResourceData, cc *config.ClusterConfig, nodes []config.Node, ports []int, addons []string) {
 
 	d.Set("addons", addons)
 	d.Set("apiserver_ips", state_utils.SliceOrNil(cc.KubernetesConfig.APIServerIPs))
@@ -187,6 +391,7 @@ func setClusterState(d *schema.ResourceData, cc *config.ClusterConfig, tfc lib.M
 	d.Set("kvm_network", cc.KVMNetwork)
 	d.Set("kvm_numa_count", cc.KVMNUMACount)
 	d.Set("kvm_qemu_uri", cc.KVMQemuURI)
+	d.Set("kubernetes_version", cc.KubernetesConfig.KubernetesVersion)
 	d.Set("listen_address", cc.ListenAddress)
 	d.Set("memory", strconv.Itoa(cc.Memory)+"mb")
 	d.Set("mount_string", cc.MountString)
@@ -196,7 +401,9 @@ func setClusterState(d *schema.ResourceData, cc *config.ClusterConfig, tfc lib.M
 	d.Set("nfs_share", state_utils.SliceOrNil(cc.NFSShare))
 	d.Set("nfs_shares_root", cc.NFSSharesRoot)
 	d.Set("no_vtx_check", cc.NoVTXCheck)
-	d.Set("nodes", tfc.Nodes)
+	d.Set("nodes", len(nodes))
+	d.Set("ha", lib.ControlPlanes(nodes) > 1)
+	d.Set("control_plane_nodes", lib.ControlPlanes(nodes))
 	d.Set("ports", state_utils.SliceOrNil(ports))
 	d.Set("registry_mirror", state_utils.SliceOrNil(cc.RegistryMirror))
 	d.Set("service_cluster_ip_range", cc.KubernetesConfig.ServiceCIDR)
@@ -210,6 +417,267 @@ func setClusterState(d *schema.ResourceData, cc *config.ClusterConfig, tfc lib.M
 	d.Set("disable_metrics", cc.DisableMetrics)
 }
 
+// resourceClusterImport reads an existing minikube profile from disk, so that it can be managed without being recreated
+func resourceClusterImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	clusterName := d.Id()
+
+	client, err := initialiseExistingClusterClient(m, clusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	cc, err := client.LoadClusterConfig()
+	if err != nil {
+		return nil, fmt.Errorf("could not import cluster %s: %w", clusterName, err)
+	}
+
+	kc, err := client.GetKubeconfig()
+	if err != nil {
+		return nil, fmt.Errorf("could not import the credentials of cluster %s: %w", clusterName, err)
+	}
+
+	attached, err := client.GetAttachedNodes()
+	if err != nil {
+		return nil, err
+	}
+
+	setImportedState(d, cc, attached)
+
+	err = setClusterOutputs(d, kc)
+	if err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// setImportedState sets the attributes that are otherwise only known from the terraform configuration,
+// such as the node count, from the persisted profile. Nodes attached by minikube_node are left out of the count
+func setImportedState(d *schema.ResourceData, cc *config.ClusterConfig, attached []string) {
+	// Attributes minikube does not persist keep their defaults, rather than planning a change after import. So does wait:
+	// minikube persists the components it verified rather than the ones asked for, and wait only matters on create
+	for k, s := range GetClusterSchema() {
+		if s.Default != nil {
+			d.Set(k, s.Default)
+		} else if s.DefaultFunc != nil {
+			if v, err := s.DefaultFunc(); err == nil && v != nil {
+				d.Set(k, v)
+			}
+		}
+	}
+
+	addons := make([]string, 0)
+	for addon, enabled := range cc.Addons {
+		if enabled {
+			addons = append(addons, addon)
+		}
+	}
+
+	d.Set("cluster_name", cc.Name)
+	d.Set("addons", addons)
+	nodes := lib.OwnedNodes(cc.Nodes, attached)
+	d.Set("nodes", len(nodes))
+	d.Set("ha", lib.ControlPlanes(nodes) > 1)
+	d.Set("kubernetes_version", cc.KubernetesConfig.KubernetesVersion)
+	d.Set("cache_images", cc.KubernetesConfig.ShouldLoadCachedImages)
+	d.Set("static_ip", cc.StaticIP)
+	d.Set("subnet", cc.Subnet)
+	d.Set("gpus", cc.GPUs)
+	d.Set("socket_vmnet_path", cc.SocketVMnetPath)
+	d.Set("socket_vmnet_client_path", cc.SocketVMnetClientPath)
+	d.Set("wait_timeout", int(cc.StartHostTimeout.Minutes()))
+}
+
+// flattenNodeInventory converts the nodes of the cluster into the node attribute, preferring the addresses reported by the driver.
+// Nodes are named after their machine, the same as in the status attribute
+func flattenNodeInventory(cc *config.ClusterConfig, nodes []config.Node, ips map[string]string) []interface{} {
+	flattened := make([]interface{}, len(nodes))
+	for i, n := range nodes {
+		ip, ok := ips[n.Name]
+		if !ok {
+			ip = n.IP
+		}
+
+		containerRuntime := n.ContainerRuntime
+		if containerRuntime == "" {
+			containerRuntime = cc.KubernetesConfig.ContainerRuntime
+		}
+
+		k8sVersion := n.KubernetesVersion
+		if k8sVersion == "" {
+			k8sVersion = cc.KubernetesConfig.KubernetesVersion
+		}
+
+		flattened[i] = map[string]interface{}{
+			"name":               config.MachineName(*cc, n),
+			"ip":                 ip,
+			"role":               lib.NodeRole(n),
+			"container_runtime":  containerRuntime,
+			"kubernetes_version": k8sVersion,
+		}
+	}
+
+	return flattened
+}
+
+// flattenStatuses converts the status of each node into the status attribute
+func flattenStatuses(statuses []*cluster.Status) []interface{} {
+	flattened := make([]interface{}, 0, len(statuses))
+	for _, s := range statuses {
+		if s == nil {
+			continue
+		}
+
+		flattened = append(flattened, map[string]interface{}{
+			"name":       s.Name,
+			"host":       s.Host,
+			"kubelet":    s.Kubelet,
+			"apiserver":  s.APIServer,
+			"kubeconfig": s.Kubeconfig,
+			"worker":     s.Worker,
+		})
+	}
+
+	return flattened
+}
+
+// setClusterOutputs stores the connection details of the provided kubeconfig in the resource state
+func setClusterOutputs(d *schema.ResourceData, kc *kubeconfig.Settings) error {
+	key, certificate, ca, address, err := getClusterOutputs(kc)
+	if err != nil {
+		return err
+	}
+
+	raw, err := lib.KubeconfigRaw(kc)
+	if err != nil {
+		return err
+	}
+
+	d.Set("client_key", key)
+	d.Set("client_certificate", certificate)
+	d.Set("cluster_ca_certificate", ca)
+	d.Set("host", address)
+	d.Set("kubeconfig_raw", raw)
+	d.Set("cluster_name", kc.ClusterName)
+
+	return nil
+}
+
+// validateKubernetesVersionChange rejects kubernetes version downgrades at plan time, as minikube can only upgrade in place
+func validateKubernetesVersionChange(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if d.Id() == "" || !d.HasChange("kubernetes_version") {
+		return nil
+	}
+
+	o, n := d.GetChange("kubernetes_version")
+	oldVersion, newVersion := o.(string), n.(string)
+	if oldVersion == "" || newVersion == "" {
+		return nil
+	}
+
+	// Aliases such as 'stable' and 'latest' are resolved by minikube itself
+	oldSemver, err := pkgutil.ParseKubernetesVersion(oldVersion)
+	if err != nil {
+		return nil
+	}
+	newSemver, err := pkgutil.ParseKubernetesVersion(newVersion)
+	if err != nil {
+		return nil
+	}
+
+	if newSemver.LT(oldSemver) {
+		return fmt.Errorf("kubernetes_version cannot be downgraded from %s to %s. minikube only supports in-place upgrades, recreate the cluster to downgrade", oldVersion, newVersion)
+	}
+
+	return nil
+}
+
+// ensureClusterRunning plans to start a cluster that was found stopped during refresh when ensure_running is set,
+// unless the desired state is set explicitly
+func ensureClusterRunning(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if d.Id() == "" || !d.Get("ensure_running").(bool) {
+		return nil
+	}
+
+	raw := d.GetRawConfig()
+	if !raw.IsNull() && raw.IsKnown() && !raw.GetAttr("state").IsNull() {
+		return nil
+	}
+
+	if d.Get("state").(string) != lib.StateStopped {
+		return nil
+	}
+
+	return d.SetNew("state", lib.StateRunning)
+}
+
+// validateStoppedClusterChanges rejects node and addon changes to a cluster that stays stopped, as both are applied
+// to its running machines
+func validateStoppedClusterChanges(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	from, to := d.GetChange("state")
+	if from.(string) != lib.StateStopped || to.(string) != lib.StateStopped {
+		return nil
+	}
+
+	for _, key := range []string{"nodes", "addons"} {
+		if d.HasChange(key) {
+			return fmt.Errorf("%s of cluster %s cannot be changed while it is stopped, set state to %s to apply the change",
+				key, d.Get("cluster_name").(string), lib.StateRunning)
+		}
+	}
+
+	return nil
+}
+
+// forceNewOnUnsupportedResize recreates the cluster when cpus or memory change on a driver that cannot resize its
+// machines in place
+func forceNewOnUnsupportedResize(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	driver := d.Get("driver").(string)
+	for _, resource := range []string{lib.ResourceCPUs, lib.ResourceMemory} {
+		if d.HasChange(resource) && !lib.CanResize(driver, resource) {
+			err := d.ForceNew(resource)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// previousKubeconfigContext looks up the current-context that creating the cluster, or moving its kubeconfig, replaces
+func previousKubeconfigContext(client lib.ClusterClient, clusterName string) (string, error) {
+	current, err := lib.CurrentContext(client.GetKubeconfigPath())
+	if err != nil || current == clusterName {
+		return "", err
+	}
+
+	return current, nil
+}
+
+// removeKubeconfigContext removes the entries of the cluster from a kubeconfig it no longer uses, restoring the
+// previous current-context if the cluster's context was still the current one
+func removeKubeconfigContext(path string, clusterName string, previousContext string) error {
+	current, err := lib.CurrentContext(path)
+	if err != nil {
+		return err
+	}
+
+	if current != clusterName {
+		previousContext = ""
+	}
+
+	return lib.RemoveContext(path, clusterName, previousContext)
+}
+
 // getClusterOutputs return the cluster key, certificate and certificate authority from the provided kubeconfig
 func getClusterOutputs(kc *kubeconfig.Settings) (string, string, string, string, error) {
 	key, err := state_utils.ReadContents(kc.ClientKey)
@@ -316,7 +784,11 @@ func initialiseMinikubeClient(d *schema.ResourceData, m interface{}) (lib.Cluste
 		}
 	}
 
-	k8sVersion := clusterClient.GetK8sVersion()
+	k8sVersion := d.Get("kubernetes_version").(string)
+	if k8sVersion == "" {
+		k8sVersion = clusterClient.GetK8sVersion()
+	}
+
 	kubernetesConfig := config.KubernetesConfig{
 		KubernetesVersion:      k8sVersion,
 		ClusterName:            d.Get("cluster_name").(string),
@@ -457,6 +929,29 @@ func initialiseMinikubeClient(d *schema.ResourceData, m interface{}) (lib.Cluste
 		Nodes:           nodes,
 		HA:              ha,
 		NativeSsh:       d.Get("native_ssh").(bool),
+		KubeconfigPath:  d.Get("kubeconfig_path").(string),
+		PreviousContext: d.Get("kubeconfig_previous_context").(string),
+	})
+
+	clusterClient.SetDependencies(lib.MinikubeClientDeps{
+		Node:       lib.NewMinikubeCluster(),
+		Downloader: lib.NewMinikubeDownloader(),
+	})
+
+	return clusterClient, nil
+}
+
+// initialiseExistingClusterClient creates a client for a cluster that already exists on the host,
+// e.g. one created by hand or by another workspace
+func initialiseExistingClusterClient(m interface{}, clusterName string) (lib.ClusterClient, error) {
+	clusterClientFactory := m.(func() (lib.ClusterClient, error))
+	clusterClient, err := clusterClientFactory()
+	if err != nil {
+		return nil, err
+	}
+
+	clusterClient.SetConfig(lib.MinikubeClientConfig{
+		ClusterName: clusterName,
 	})
 
 	clusterClient.SetDependencies(lib.MinikubeClientDeps{
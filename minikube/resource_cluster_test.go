@@ -9,6 +9,8 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"reflect"
+	"regexp"
 	"runtime"
 	"strings"
 	"testing"
@@ -22,6 +24,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"k8s.io/minikube/pkg/minikube/cluster"
 	"k8s.io/minikube/pkg/minikube/config"
 	"k8s.io/minikube/pkg/minikube/kubeconfig"
 	"k8s.io/minikube/pkg/minikube/localpath"
@@ -49,6 +52,8 @@ func TestClusterCreation(t *testing.T) {
 				Config: testUnitClusterConfig("some_driver", "TestClusterCreation"),
 				Check: resource.ComposeTestCheckFunc(
 					testPropertyExists("minikube_cluster.new", "TestClusterCreation"),
+					resource.TestCheckResourceAttr("minikube_cluster.new", "kubeconfig_path", "test_output/kubeconfig"),
+					resource.TestCheckResourceAttrSet("minikube_cluster.new", "kubeconfig_raw"),
 				),
 			},
 		},
@@ -109,6 +114,138 @@ func TestClusterWait(t *testing.T) {
 	})
 }
 
+func TestClusterKubernetesVersionUpgrade(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  map[string]*schema.Provider{"minikube": NewProvider(mockUpgrade(mockClusterClientProperties{t, "TestClusterKubernetesVersionUpgrade", 1, 0, 20000, "4096mb", "2"}, "v1.27.0"))},
+		Steps: []resource.TestStep{
+			{
+				Config: testUnitClusterKubernetesVersionConfig("some_driver", "TestClusterKubernetesVersionUpgrade", "v1.26.3"),
+			},
+			{
+				Config: testUnitClusterKubernetesVersionConfig("some_driver", "TestClusterKubernetesVersionUpgrade", "v1.27.0"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("minikube_cluster.new", "kubernetes_version", "v1.27.0"),
+				),
+			},
+		},
+	})
+}
+
+func TestClusterKubernetesVersionDowngrade(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  map[string]*schema.Provider{"minikube": NewProvider(mockSuccess(mockClusterClientProperties{t, "TestClusterKubernetesVersionDowngrade", 1, 0, 20000, "4096mb", "2"}))},
+		Steps: []resource.TestStep{
+			{
+				Config: testUnitClusterKubernetesVersionConfig("some_driver", "TestClusterKubernetesVersionDowngrade", "v1.26.3"),
+			},
+			{
+				Config:      testUnitClusterKubernetesVersionConfig("some_driver", "TestClusterKubernetesVersionDowngrade", "v1.25.0"),
+				ExpectError: regexp.MustCompile("cannot be downgraded"),
+			},
+		},
+	})
+}
+
+func TestClusterRestartSettings(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers: map[string]*schema.Provider{"minikube": NewProvider(mockRestart(mockClusterClientProperties{t, "TestClusterRestartSettings", 1, 0, 20000, "4096mb", "2"}, func(cc *config.ClusterConfig) {
+			cc.KubernetesConfig.FeatureGates = "EphemeralContainers=true"
+			cc.KubernetesConfig.APIServerNames = []string{"minikubeCA", "cluster.local"}
+		}))},
+		Steps: []resource.TestStep{
+			{
+				Config: testUnitClusterConfig("some_driver", "TestClusterRestartSettings"),
+			},
+			{
+				Config: testUnitClusterRestartSettingsConfig("some_driver", "TestClusterRestartSettings"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("minikube_cluster.new", "id", "TestClusterRestartSettings"),
+					resource.TestCheckResourceAttr("minikube_cluster.new", "feature_gates", "EphemeralContainers=true"),
+					resource.TestCheckResourceAttr("minikube_cluster.new", "apiserver_names.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestClusterState(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  map[string]*schema.Provider{"minikube": NewProvider(mockState(mockClusterClientProperties{t, "TestClusterState", 1, 0, 20000, "4096mb", "2"}))},
+		Steps: []resource.TestStep{
+			{
+				Config: testUnitClusterStateConfig("some_driver", "TestClusterState", "running"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("minikube_cluster.new", "state", "running"),
+					resource.TestCheckResourceAttr("minikube_cluster.new", "health", "healthy"),
+					resource.TestCheckResourceAttr("minikube_cluster.new", "status.#", "1"),
+					resource.TestCheckResourceAttr("minikube_cluster.new", "status.0.apiserver", "Running"),
+					resource.TestCheckResourceAttr("minikube_cluster.new", "node.#", "1"),
+					resource.TestCheckResourceAttr("minikube_cluster.new", "node.0.ip", "192.168.49.2"),
+					resource.TestCheckResourceAttr("minikube_cluster.new", "node.0.role", "control-plane"),
+				),
+			},
+			{
+				Config: testUnitClusterStateConfig("some_driver", "TestClusterState", "stopped"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("minikube_cluster.new", "state", "stopped"),
+					resource.TestCheckResourceAttr("minikube_cluster.new", "health", "stopped"),
+				),
+			},
+			{
+				Config:      testUnitClusterStoppedNodesConfig("some_driver", "TestClusterState"),
+				ExpectError: regexp.MustCompile("nodes of cluster TestClusterState cannot be changed while it is stopped"),
+			},
+		},
+	})
+}
+
+func TestClusterEnsureRunning(t *testing.T) {
+	configureContext, reboot := mockEnsureRunning(mockClusterClientProperties{t, "TestClusterEnsureRunning", 1, 0, 20000, "4096mb", "2"})
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  map[string]*schema.Provider{"minikube": NewProvider(configureContext)},
+		Steps: []resource.TestStep{
+			{
+				Config: testUnitClusterEnsureRunningConfig("some_driver", "TestClusterEnsureRunning"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("minikube_cluster.new", "state", "running"),
+				),
+			},
+			{
+				PreConfig: reboot,
+				Config:    testUnitClusterEnsureRunningConfig("some_driver", "TestClusterEnsureRunning"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("minikube_cluster.new", "state", "running"),
+				),
+			},
+		},
+	})
+}
+
+func TestClusterResize(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  map[string]*schema.Provider{"minikube": NewProvider(mockResize(mockClusterClientProperties{t, "TestClusterResize", 1, 0, 20000, "4096mb", "2"}))},
+		Steps: []resource.TestStep{
+			{
+				Config: testUnitClusterResizeConfig("docker", "TestClusterResize", "4096mb", "2"),
+			},
+			{
+				Config: testUnitClusterResizeConfig("docker", "TestClusterResize", "8192mb", "4"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("minikube_cluster.new", "memory", "8192mb"),
+					resource.TestCheckResourceAttr("minikube_cluster.new", "cpus", "4"),
+					resource.TestCheckResourceAttr("minikube_cluster.new", "state", "running"),
+				),
+			},
+		},
+	})
+}
+
 func TestClusterCreation_Docker(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		Providers:    map[string]*schema.Provider{"minikube": Provider()},
@@ -377,6 +514,463 @@ func TestClusterMaxCPU(t *testing.T) {
 	})
 }
 
+func TestClusterReadDeletedOutsideTerraform(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockClusterClient := lib.NewMockClusterClient(ctrl)
+
+	mockClusterClient.EXPECT().
+		SetConfig(gomock.Any()).
+		AnyTimes()
+
+	mockClusterClient.EXPECT().
+		SetDependencies(gomock.Any()).
+		AnyTimes()
+
+	mockClusterClient.EXPECT().
+		GetK8sVersion().
+		Return("v1.99.9").
+		AnyTimes()
+
+	mockClusterClient.EXPECT().
+		LoadClusterConfig().
+		Return(nil, fmt.Errorf("%w: TestClusterReadDeletedOutsideTerraform", lib.ErrClusterNotFound))
+
+	mockClusterClientFactory := func() (lib.ClusterClient, error) {
+		return mockClusterClient, nil
+	}
+
+	d := schema.TestResourceDataRaw(t, ResourceCluster().Schema, map[string]interface{}{
+		"driver":       "some_driver",
+		"cluster_name": "TestClusterReadDeletedOutsideTerraform",
+	})
+	d.SetId("TestClusterReadDeletedOutsideTerraform")
+
+	diags := resourceClusterRead(context.Background(), d, mockClusterClientFactory)
+	if diags.HasError() {
+		t.Fatalf("resourceClusterRead() returned errors: %v", diags)
+	}
+
+	if d.Id() != "" {
+		t.Errorf("resourceClusterRead() id = %q, want it removed from state", d.Id())
+	}
+}
+
+func TestClusterDestroyMode(t *testing.T) {
+	tests := []struct {
+		name   string
+		mode   string
+		expect func(mockClusterClient *lib.MockClusterClient)
+	}{
+		{
+			name: "Delete",
+			mode: lib.DestroyModeDelete,
+			expect: func(mockClusterClient *lib.MockClusterClient) {
+				mockClusterClient.EXPECT().Delete(gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name: "Purge",
+			mode: lib.DestroyModePurge,
+			expect: func(mockClusterClient *lib.MockClusterClient) {
+				mockClusterClient.EXPECT().Purge(gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name: "Stop",
+			mode: lib.DestroyModeStop,
+			expect: func(mockClusterClient *lib.MockClusterClient) {
+				mockClusterClient.EXPECT().Stop(gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name: "StopDeletedCluster",
+			mode: lib.DestroyModeStop,
+			expect: func(mockClusterClient *lib.MockClusterClient) {
+				mockClusterClient.EXPECT().Stop(gomock.Any()).Return(fmt.Errorf("%w: TestClusterDestroyMode", lib.ErrClusterNotFound))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockClusterClient := lib.NewMockClusterClient(ctrl)
+
+			mockClusterClient.EXPECT().
+				SetConfig(gomock.Any()).
+				AnyTimes()
+
+			mockClusterClient.EXPECT().
+				SetDependencies(gomock.Any()).
+				AnyTimes()
+
+			mockClusterClient.EXPECT().
+				GetK8sVersion().
+				Return("v1.99.9").
+				AnyTimes()
+
+			tt.expect(mockClusterClient)
+
+			mockClusterClientFactory := func() (lib.ClusterClient, error) {
+				return mockClusterClient, nil
+			}
+
+			d := schema.TestResourceDataRaw(t, ResourceCluster().Schema, map[string]interface{}{
+				"driver":       "some_driver",
+				"cluster_name": "TestClusterDestroyMode",
+				"destroy_mode": tt.mode,
+			})
+			d.SetId("TestClusterDestroyMode")
+
+			diags := resourceClusterDelete(context.Background(), d, mockClusterClientFactory)
+			if diags.HasError() {
+				t.Fatalf("resourceClusterDelete() returned errors: %v", diags)
+			}
+
+			if d.Id() != "" {
+				t.Errorf("resourceClusterDelete() id = %q, want it removed from state", d.Id())
+			}
+		})
+	}
+}
+
+func TestClusterDeleteFailure(t *testing.T) {
+	tests := []struct {
+		name        string
+		expect      func(mockClusterClient *lib.MockClusterClient)
+		wantErr     bool
+		wantSummary string
+	}{
+		{
+			name: "Retries Until Deleted",
+			expect: func(mockClusterClient *lib.MockClusterClient) {
+				gomock.InOrder(
+					mockClusterClient.EXPECT().Delete(gomock.Any()).Return(errors.New("container is still running")),
+					mockClusterClient.EXPECT().Delete(gomock.Any()).Return(nil),
+				)
+			},
+			wantErr: false,
+		},
+		{
+			name: "Keeps State When Delete Keeps Failing",
+			expect: func(mockClusterClient *lib.MockClusterClient) {
+				mockClusterClient.EXPECT().
+					Delete(gomock.Any()).
+					Return(errors.New("/minikube/machines/TestClusterDeleteFailure still exist")).
+					MinTimes(2)
+			},
+			wantErr:     true,
+			wantSummary: "still exist",
+		},
+		{
+			name: "Gives Up When The Lock Is Held",
+			expect: func(mockClusterClient *lib.MockClusterClient) {
+				mockClusterClient.EXPECT().
+					Delete(gomock.Any()).
+					Return(&lib.LockTimeoutError{Path: "profile-TestClusterDeleteFailure.lock", Holder: "pid 1", Timeout: time.Minute}).
+					Times(1)
+			},
+			wantErr:     true,
+			wantSummary: "held by pid 1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockClusterClient := lib.NewMockClusterClient(ctrl)
+
+			mockClusterClient.EXPECT().
+				SetConfig(gomock.Any()).
+				AnyTimes()
+
+			mockClusterClient.EXPECT().
+				SetDependencies(gomock.Any()).
+				AnyTimes()
+
+			mockClusterClient.EXPECT().
+				GetK8sVersion().
+				Return("v1.99.9").
+				AnyTimes()
+
+			tt.expect(mockClusterClient)
+
+			mockClusterClientFactory := func() (lib.ClusterClient, error) {
+				return mockClusterClient, nil
+			}
+
+			// Keep retrying for a couple of seconds only
+			r := ResourceCluster()
+			timeout := 2 * time.Second
+			r.Timeouts.Delete = &timeout
+
+			d := r.Data(nil)
+			d.Set("driver", "some_driver")
+			d.Set("cluster_name", "TestClusterDeleteFailure")
+			d.SetId("TestClusterDeleteFailure")
+
+			diags := resourceClusterDelete(context.Background(), d, mockClusterClientFactory)
+			if diags.HasError() != tt.wantErr {
+				t.Fatalf("resourceClusterDelete() diags = %v, wantErr %v", diags, tt.wantErr)
+			}
+
+			if !tt.wantErr {
+				if d.Id() != "" {
+					t.Errorf("resourceClusterDelete() id = %q, want it removed from state", d.Id())
+				}
+				return
+			}
+
+			if d.Id() != "TestClusterDeleteFailure" {
+				t.Errorf("resourceClusterDelete() id = %q, want the cluster kept in state", d.Id())
+			}
+			if !strings.Contains(diags[0].Summary, tt.wantSummary) {
+				t.Errorf("resourceClusterDelete() summary = %q, want the underlying error", diags[0].Summary)
+			}
+			if !strings.Contains(diags[0].Detail, "minikube delete -p TestClusterDeleteFailure") {
+				t.Errorf("resourceClusterDelete() detail = %q, want a hint to delete the cluster by hand", diags[0].Detail)
+			}
+		})
+	}
+}
+
+func TestClusterCreateInterrupted(t *testing.T) {
+	tests := []struct {
+		name            string
+		deleteOnFailure bool
+		wantID          string
+	}{
+		{
+			name:            "Keeps The Partial Cluster Tainted",
+			deleteOnFailure: false,
+			wantID:          "TestClusterCreateInterrupted",
+		},
+		{
+			name:            "Drops The Deleted Cluster",
+			deleteOnFailure: true,
+			wantID:          "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockClusterClient := lib.NewMockClusterClient(ctrl)
+
+			mockClusterClient.EXPECT().
+				SetConfig(gomock.Any()).
+				AnyTimes()
+
+			mockClusterClient.EXPECT().
+				SetDependencies(gomock.Any()).
+				AnyTimes()
+
+			mockClusterClient.EXPECT().
+				GetK8sVersion().
+				Return("v1.99.9").
+				AnyTimes()
+
+			mockClusterClient.EXPECT().
+				GetKubeconfigPath().
+				Return(filepath.Join(t.TempDir(), "config")).
+				AnyTimes()
+
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+
+			mockClusterClient.EXPECT().
+				Start(gomock.Any()).
+				Return(nil, fmt.Errorf("creating cluster TestClusterCreateInterrupted was interrupted: %w", context.Canceled))
+
+			mockClusterClientFactory := func() (lib.ClusterClient, error) {
+				return mockClusterClient, nil
+			}
+
+			d := schema.TestResourceDataRaw(t, ResourceCluster().Schema, map[string]interface{}{
+				"driver":            "some_driver",
+				"cluster_name":      "TestClusterCreateInterrupted",
+				"delete_on_failure": tt.deleteOnFailure,
+			})
+
+			diags := resourceClusterCreate(ctx, d, mockClusterClientFactory)
+			if !diags.HasError() {
+				t.Fatalf("resourceClusterCreate() expected an error for an interrupted create")
+			}
+
+			if d.Id() != tt.wantID {
+				t.Errorf("resourceClusterCreate() id = %q, want %q", d.Id(), tt.wantID)
+			}
+		})
+	}
+}
+
+func TestClusterImport(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockClusterClient := lib.NewMockClusterClient(ctrl)
+
+	os.Mkdir("test_output", 0755)
+	_ = os.WriteFile("test_output/ca", []byte("test ca"), 0644)
+	_ = os.WriteFile("test_output/certificate", []byte("test certificate"), 0644)
+	_ = os.WriteFile("test_output/key", []byte("test key"), 0644)
+
+	mockClusterClient.EXPECT().
+		SetConfig(lib.MinikubeClientConfig{ClusterName: "TestClusterImport"}).
+		AnyTimes()
+
+	mockClusterClient.EXPECT().
+		SetDependencies(gomock.Any()).
+		AnyTimes()
+
+	mockClusterClient.EXPECT().
+		LoadClusterConfig().
+		Return(&config.ClusterConfig{
+			Name:             "TestClusterImport",
+			Driver:           "docker",
+			StaticIP:         "192.168.200.200",
+			GPUs:             "nvidia",
+			StartHostTimeout: 6 * time.Minute,
+			Addons:           map[string]bool{"dashboard": true, "ingress": false},
+			VerifyComponents: map[string]bool{"apiserver": true, "system_pods": true},
+			KubernetesConfig: config.KubernetesConfig{KubernetesVersion: "v1.28.3"},
+			Nodes: []config.Node{
+				{Name: "", ControlPlane: true, Worker: true},
+				{Name: "m02", ControlPlane: true, Worker: true},
+				{Name: "m03", ControlPlane: true, Worker: true},
+				{Name: "m04", Worker: true},
+			},
+		}, nil)
+
+	mockClusterClient.EXPECT().
+		GetKubeconfig().
+		Return(&kubeconfig.Settings{
+			ClusterName:          "TestClusterImport",
+			ClusterServerAddress: "https://192.168.200.200:8443",
+			ClientCertificate:    "test_output/certificate",
+			CertificateAuthority: "test_output/ca",
+			ClientKey:            "test_output/key",
+		}, nil)
+
+	mockClusterClient.EXPECT().
+		GetAttachedNodes().
+		Return(nil, nil)
+
+	mockClusterClientFactory := func() (lib.ClusterClient, error) {
+		return mockClusterClient, nil
+	}
+
+	d := ResourceCluster().Data(nil)
+	d.SetId("TestClusterImport")
+
+	imported, err := resourceClusterImport(context.Background(), d, mockClusterClientFactory)
+	if err != nil {
+		t.Fatalf("resourceClusterImport() error = %v", err)
+	}
+
+	state := imported[0]
+	expected := map[string]interface{}{
+		"cluster_name":           "TestClusterImport",
+		"host":                   "https://192.168.200.200:8443",
+		"client_key":             "test key",
+		"client_certificate":     "test certificate",
+		"cluster_ca_certificate": "test ca",
+		"nodes":                  4,
+		"ha":                     true,
+		"kubernetes_version":     "v1.28.3",
+		"static_ip":              "192.168.200.200",
+		"gpus":                   "nvidia",
+		"wait_timeout":           6,
+		"delete_on_failure":      false,
+	}
+	for k, want := range expected {
+		if got := state.Get(k); got != want {
+			t.Errorf("imported %s = %v, want %v", k, got, want)
+		}
+	}
+
+	if got := state_utils.SetToSlice(state.Get("addons").(*schema.Set)); !reflect.DeepEqual(got, []string{"dashboard"}) {
+		t.Errorf("imported addons = %v, want [dashboard]", got)
+	}
+
+	if got := state.Get("wait").(*schema.Set).Len(); got != 0 {
+		t.Errorf("imported wait has %d components, want it left unset", got)
+	}
+}
+
+func TestClusterImportPlan(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockClusterClient := lib.NewMockClusterClient(ctrl)
+
+	os.Mkdir("test_output", 0755)
+	_ = os.WriteFile("test_output/ca", []byte("test ca"), 0644)
+	_ = os.WriteFile("test_output/certificate", []byte("test certificate"), 0644)
+	_ = os.WriteFile("test_output/key", []byte("test key"), 0644)
+
+	clusterSchema := ResourceCluster().Schema
+	socketVMnetPath, _ := clusterSchema["socket_vmnet_path"].DefaultFunc()
+	socketVMnetClientPath, _ := clusterSchema["socket_vmnet_client_path"].DefaultFunc()
+
+	mockClusterClient.EXPECT().
+		SetConfig(gomock.Any()).
+		AnyTimes()
+
+	mockClusterClient.EXPECT().
+		SetDependencies(gomock.Any()).
+		AnyTimes()
+
+	mockClusterClient.EXPECT().
+		LoadClusterConfig().
+		Return(&config.ClusterConfig{
+			Name:                  "TestClusterImportPlan",
+			Driver:                "docker",
+			StartHostTimeout:      6 * time.Minute,
+			SocketVMnetPath:       socketVMnetPath.(string),
+			SocketVMnetClientPath: socketVMnetClientPath.(string),
+			Addons:                map[string]bool{"dashboard": true},
+			VerifyComponents:      map[string]bool{"apiserver": true, "system_pods": true, "node_ready": true},
+			KubernetesConfig:      config.KubernetesConfig{KubernetesVersion: "v1.28.3", ShouldLoadCachedImages: true},
+			Nodes:                 []config.Node{{Name: "", ControlPlane: true, Worker: true}},
+		}, nil)
+
+	mockClusterClient.EXPECT().
+		GetKubeconfig().
+		Return(&kubeconfig.Settings{
+			ClusterName:          "TestClusterImportPlan",
+			ClusterServerAddress: "https://192.168.49.2:8443",
+			ClientCertificate:    "test_output/certificate",
+			CertificateAuthority: "test_output/ca",
+			ClientKey:            "test_output/key",
+		}, nil)
+
+	mockClusterClient.EXPECT().
+		GetAttachedNodes().
+		Return(nil, nil)
+
+	mockClusterClientFactory := func() (lib.ClusterClient, error) {
+		return mockClusterClient, nil
+	}
+
+	d := ResourceCluster().Data(nil)
+	d.SetId("TestClusterImportPlan")
+
+	imported, err := resourceClusterImport(context.Background(), d, mockClusterClientFactory)
+	if err != nil {
+		t.Fatalf("resourceClusterImport() error = %v", err)
+	}
+
+	// The configuration the cluster was created with, which should plan no changes once imported
+	cfg := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"cluster_name":       "TestClusterImportPlan",
+		"kubernetes_version": "v1.28.3",
+		"addons":             []interface{}{"dashboard"},
+	})
+
+	diff, err := ResourceCluster().SimpleDiff(context.Background(), imported[0].State(), cfg, mockClusterClientFactory)
+	if err != nil {
+		t.Fatalf("SimpleDiff() error = %v", err)
+	}
+
+	if !diff.Empty() {
+		t.Errorf("plan after import = %v, want no changes", diff.Attributes)
+	}
+}
+
 func mockUpdate(props mockClusterClientProperties) schema.ConfigureContextFunc {
 	ctrl := gomock.NewController(props.t)
 
@@ -414,6 +1008,171 @@ func mockUpdate(props mockClusterClientProperties) schema.ConfigureContextFunc {
 	return configureContext
 }
 
+func mockUpgrade(props mockClusterClientProperties, k8sVersion string) schema.ConfigureContextFunc {
+	return mockRestart(props, func(cc *config.ClusterConfig) {
+		cc.KubernetesConfig.KubernetesVersion = k8sVersion
+	})
+}
+
+// mockRestart expects a single restart, which applies the changed config to the cluster
+func mockRestart(props mockClusterClientProperties, apply func(cc *config.ClusterConfig)) schema.ConfigureContextFunc {
+	ctrl := gomock.NewController(props.t)
+
+	mockClusterClient := getBaseMockClient(props.t, ctrl, props.name, props.haNodes, props.workerNodes, props.diskSize, props.memory, props.cpu)
+
+	mockClusterClient.EXPECT().
+		GetAddons().
+		Return(nil).
+		AnyTimes()
+
+	mockClusterClient.EXPECT().
+		Restart(gomock.Any()).
+		DoAndReturn(func(ctx context.Context) (*kubeconfig.Settings, error) {
+			apply(mockClusterClient.GetClusterConfig())
+			return &kubeconfig.Settings{
+				ClusterName:          props.name,
+				ClusterServerAddress: "http://localhost:8080",
+				ClientCertificate:    "test_output/ca",
+				CertificateAuthority: "test_output/certificate",
+				ClientKey:            "test_output/key",
+			}, nil
+		}).
+		Times(1)
+
+	configureContext := func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+		var diags diag.Diagnostics
+		mockClusterClientFactory := func() (lib.ClusterClient, error) {
+			return mockClusterClient, nil
+		}
+		return mockClusterClientFactory, diags
+	}
+
+	return configureContext
+}
+
+func mockState(props mockClusterClientProperties) schema.ConfigureContextFunc {
+	ctrl := gomock.NewController(props.t)
+
+	mockClusterClient := getBaseMockClient(props.t, ctrl, props.name, props.haNodes, props.workerNodes, props.diskSize, props.memory, props.cpu)
+
+	mockClusterClient.EXPECT().
+		GetAddons().
+		Return(nil).
+		AnyTimes()
+
+	mockClusterClient.EXPECT().
+		Stop(gomock.Any()).
+		DoAndReturn(func(ctx context.Context) error {
+			statuses, _ := mockClusterClient.GetStatus(context.Background(), nil)
+			for _, s := range statuses {
+				s.Host = "Stopped"
+				s.Kubelet = "Stopped"
+				s.APIServer = "Stopped"
+			}
+			return nil
+		}).
+		Times(1)
+
+	configureContext := func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+		var diags diag.Diagnostics
+		mockClusterClientFactory := func() (lib.ClusterClient, error) {
+			return mockClusterClient, nil
+		}
+		return mockClusterClientFactory, diags
+	}
+
+	return configureContext
+}
+
+func mockResize(props mockClusterClientProperties) schema.ConfigureContextFunc {
+	ctrl := gomock.NewController(props.t)
+
+	mockClusterClient := getBaseMockClient(props.t, ctrl, props.name, props.haNodes, props.workerNodes, props.diskSize, props.memory, props.cpu)
+	mockClusterClient.GetClusterConfig().Driver = lib.Docker
+
+	mockClusterClient.EXPECT().
+		GetAddons().
+		Return(nil).
+		AnyTimes()
+
+	gomock.InOrder(
+		mockClusterClient.EXPECT().
+			Resize(gomock.Any()).
+			Return(nil).
+			Times(1),
+		mockClusterClient.EXPECT().
+			Restart(gomock.Any()).
+			DoAndReturn(func(ctx context.Context) (*kubeconfig.Settings, error) {
+				cc := mockClusterClient.GetClusterConfig()
+				cc.Memory = 8192
+				cc.CPUs = 4
+				return &kubeconfig.Settings{
+					ClusterName:          props.name,
+					ClusterServerAddress: "http://localhost:8080",
+					ClientCertificate:    "test_output/ca",
+					CertificateAuthority: "test_output/certificate",
+					ClientKey:            "test_output/key",
+				}, nil
+			}).
+			Times(1),
+	)
+
+	configureContext := func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+		var diags diag.Diagnostics
+		mockClusterClientFactory := func() (lib.ClusterClient, error) {
+			return mockClusterClient, nil
+		}
+		return mockClusterClientFactory, diags
+	}
+
+	return configureContext
+}
+
+// mockEnsureRunning returns a client along with a func that stops its machines, as a host reboot would
+func mockEnsureRunning(props mockClusterClientProperties) (schema.ConfigureContextFunc, func()) {
+	ctrl := gomock.NewController(props.t)
+
+	mockClusterClient := getBaseMockClient(props.t, ctrl, props.name, props.haNodes, props.workerNodes, props.diskSize, props.memory, props.cpu)
+
+	mockClusterClient.EXPECT().
+		GetAddons().
+		Return(nil).
+		AnyTimes()
+
+	setHostState := func(state string) {
+		statuses, _ := mockClusterClient.GetStatus(context.Background(), nil)
+		for _, s := range statuses {
+			s.Host = state
+			s.Kubelet = state
+			s.APIServer = state
+		}
+	}
+
+	mockClusterClient.EXPECT().
+		Restart(gomock.Any()).
+		DoAndReturn(func(ctx context.Context) (*kubeconfig.Settings, error) {
+			setHostState("Running")
+			return &kubeconfig.Settings{
+				ClusterName:          props.name,
+				ClusterServerAddress: "http://localhost:8081",
+				ClientCertificate:    "test_output/ca",
+				CertificateAuthority: "test_output/certificate",
+				ClientKey:            "test_output/key",
+			}, nil
+		}).
+		Times(1)
+
+	configureContext := func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+		var diags diag.Diagnostics
+		mockClusterClientFactory := func() (lib.ClusterClient, error) {
+			return mockClusterClient, nil
+		}
+		return mockClusterClientFactory, diags
+	}
+
+	return configureContext, func() { setHostState("Stopped") }
+}
+
 func mockSuccess(props mockClusterClientProperties) schema.ConfigureContextFunc {
 	ctrl := gomock.NewController(props.t)
 
@@ -474,6 +1233,20 @@ func getBaseMockClient(t *testing.T, ctrl *gomock.Controller, clusterName string
 		Worker:            true,
 	}
 
+	// The primary control plane, followed by the additional control planes and the workers
+	nodes := []config.Node{n}
+	for i := 1; i < haNodes; i++ {
+		cp := n
+		cp.Name = fmt.Sprintf("m%02d", len(nodes)+1)
+		nodes = append(nodes, cp)
+	}
+	for i := 0; i < workerNodes; i++ {
+		w := n
+		w.Name = fmt.Sprintf("m%02d", len(nodes)+1)
+		w.ControlPlane = false
+		nodes = append(nodes, w)
+	}
+
 	mem, err := state_utils.GetMemory(memory)
 	if err != nil {
 		t.Fatalf("Failed to get memory: %v", err)
@@ -538,13 +1311,11 @@ func getBaseMockClient(t *testing.T, ctrl *gomock.Controller, clusterName string
 		MountUID:                "docker",
 		BinaryMirror:            "",
 		DisableOptimizations:    clusterSchema["hyperv_use_external_switch"].Default.(bool),
-		Nodes: []config.Node{
-			n,
-		},
-		KubernetesConfig:   kubernetesConfig,
-		MultiNodeRequested: false,
-		DisableCoreDNSLog:  clusterSchema["disable_coredns_log"].Default.(bool),
-		DisableMetrics:     clusterSchema["disable_metrics"].Default.(bool),
+		Nodes:                   nodes,
+		KubernetesConfig:        kubernetesConfig,
+		MultiNodeRequested:      false,
+		DisableCoreDNSLog:       clusterSchema["disable_coredns_log"].Default.(bool),
+		DisableMetrics:          clusterSchema["disable_metrics"].Default.(bool),
 	}
 
 	mockClusterClient.EXPECT().
@@ -556,7 +1327,7 @@ func getBaseMockClient(t *testing.T, ctrl *gomock.Controller, clusterName string
 		AnyTimes()
 
 	mockClusterClient.EXPECT().
-		Start().
+		Start(gomock.Any()).
 		Return(&kubeconfig.Settings{
 			ClusterName:          clusterName,
 			Namespace:            "default",
@@ -577,7 +1348,42 @@ func getBaseMockClient(t *testing.T, ctrl *gomock.Controller, clusterName string
 		AnyTimes()
 
 	mockClusterClient.EXPECT().
-		Delete().
+		LoadClusterConfig().
+		Return(&cc, nil).
+		AnyTimes()
+
+	mockClusterClient.EXPECT().
+		GetAttachedNodes().
+		Return(nil, nil).
+		AnyTimes()
+
+	statuses := make([]*cluster.Status, len(nodes))
+	for i, n := range nodes {
+		statuses[i] = &cluster.Status{Name: n.Name, Host: "Running", Kubelet: "Running", APIServer: "Running", Worker: !n.ControlPlane}
+	}
+
+	mockClusterClient.EXPECT().
+		GetStatus(gomock.Any(), gomock.Any()).
+		Return(statuses, nil).
+		AnyTimes()
+
+	ips := make(map[string]string, len(nodes))
+	for i, n := range nodes {
+		ips[n.Name] = fmt.Sprintf("192.168.49.%d", i+2)
+	}
+
+	mockClusterClient.EXPECT().
+		GetNodeIPs(gomock.Any(), gomock.Any()).
+		Return(ips, nil).
+		AnyTimes()
+
+	mockClusterClient.EXPECT().
+		GetKubeconfigPath().
+		Return("test_output/kubeconfig").
+		AnyTimes()
+
+	mockClusterClient.EXPECT().
+		Delete(gomock.Any()).
 		Return(nil)
 
 	mockClusterClient.EXPECT().
@@ -586,7 +1392,7 @@ func getBaseMockClient(t *testing.T, ctrl *gomock.Controller, clusterName string
 		AnyTimes()
 
 	mockClusterClient.EXPECT().
-		ApplyAddons(gomock.Any()).
+		ApplyAddons(gomock.Any(), gomock.Any()).
 		Return(nil).
 		AnyTimes()
 
@@ -610,6 +1416,69 @@ func testUnitClusterConfig(driver string, clusterName string) string {
 	`, driver, clusterName)
 }
 
+func testUnitClusterKubernetesVersionConfig(driver string, clusterName string, k8sVersion string) string {
+	return fmt.Sprintf(`
+	resource "minikube_cluster" "new" {
+		driver = "%s"
+		cluster_name = "%s"
+		kubernetes_version = "%s"
+	}
+	`, driver, clusterName, k8sVersion)
+}
+
+func testUnitClusterRestartSettingsConfig(driver string, clusterName string) string {
+	return fmt.Sprintf(`
+	resource "minikube_cluster" "new" {
+		driver = "%s"
+		cluster_name = "%s"
+		feature_gates = "EphemeralContainers=true"
+		apiserver_names = ["minikubeCA", "cluster.local"]
+	}
+	`, driver, clusterName)
+}
+
+func testUnitClusterStateConfig(driver string, clusterName string, state string) string {
+	return fmt.Sprintf(`
+	resource "minikube_cluster" "new" {
+		driver = "%s"
+		cluster_name = "%s"
+		state = "%s"
+	}
+	`, driver, clusterName, state)
+}
+
+func testUnitClusterStoppedNodesConfig(driver string, clusterName string) string {
+	return fmt.Sprintf(`
+	resource "minikube_cluster" "new" {
+		driver = "%s"
+		cluster_name = "%s"
+		state = "stopped"
+		nodes = 2
+	}
+	`, driver, clusterName)
+}
+
+func testUnitClusterEnsureRunningConfig(driver string, clusterName string) string {
+	return fmt.Sprintf(`
+	resource "minikube_cluster" "new" {
+		driver = "%s"
+		cluster_name = "%s"
+		ensure_running = true
+	}
+	`, driver, clusterName)
+}
+
+func testUnitClusterResizeConfig(driver string, clusterName string, memory string, cpus string) string {
+	return fmt.Sprintf(`
+	resource "minikube_cluster" "new" {
+		driver = "%s"
+		cluster_name = "%s"
+		memory = "%s"
+		cpus = "%s"
+	}
+	`, driver, clusterName, memory, cpus)
+}
+
 func testUnitClusterDiskConfig(driver string, clusterName string) string {
 	return fmt.Sprintf(`
 	resource "minikube_cluster" "new" {
@@ -0,0 +1,189 @@
+package minikube
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/scott-the-programmer/terraform-provider-minikube/minikube/lib"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceNode() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Used to attach a single worker or control plane node to an existing minikube cluster, independently of the cluster's lifecycle",
+		CreateContext: resourceNodeCreate,
+		ReadContext:   resourceNodeRead,
+		DeleteContext: resourceNodeDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceNodeImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"cluster_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the minikube cluster to attach the node to",
+			},
+
+			"role": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      lib.RoleWorker,
+				ValidateFunc: validation.StringInSlice([]string{lib.RoleWorker, lib.RoleControlPlane}, false),
+				Description:  "The role of the node, either `worker` or `control-plane`. Control plane nodes can only be added to highly available clusters",
+			},
+
+			"container_runtime": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The container runtime of the node. Defaults to the container runtime of the cluster",
+			},
+
+			"kubernetes_version": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The Kubernetes version of the node (ex: v1.2.3). Defaults to the Kubernetes version of the cluster",
+			},
+
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name minikube assigned to the node, e.g. m02",
+			},
+
+			"ip": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The IP address of the node",
+			},
+		},
+	}
+}
+
+func resourceNodeCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clusterName := d.Get("cluster_name").(string)
+
+	client, err := initialiseExistingClusterClient(m, clusterName)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	n, err := client.AddNode(ctx, lib.NodeOptions{
+		Role:              d.Get("role").(string),
+		ContainerRuntime:  d.Get("container_runtime").(string),
+		KubernetesVersion: d.Get("kubernetes_version").(string),
+	})
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	d.SetId(nodeId(clusterName, n.Name))
+
+	return resourceNodeRead(ctx, d, m)
+}
+
+func resourceNodeRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	clusterName, name, err := parseNodeId(d.Id())
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	client, err := initialiseExistingClusterClient(m, clusterName)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	cc, err := client.LoadClusterConfig()
+	if err != nil && !errors.Is(err, lib.ErrClusterNotFound) {
+		return diagFromErr(err)
+	}
+
+	if cc != nil {
+		for _, n := range cc.Nodes {
+			if n.Name != name {
+				continue
+			}
+
+			d.Set("cluster_name", clusterName)
+			d.Set("name", n.Name)
+			d.Set("ip", n.IP)
+			d.Set("role", lib.NodeRole(n))
+			d.Set("container_runtime", n.ContainerRuntime)
+			d.Set("kubernetes_version", n.KubernetesVersion)
+
+			return diags
+		}
+	}
+
+	// The node, or its whole cluster, was removed outside of terraform
+	d.SetId("")
+
+	return diags
+}
+
+func resourceNodeDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	client, err := initialiseExistingClusterClient(m, d.Get("cluster_name").(string))
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	// A cluster deleted outside of terraform took the node with it
+	err = client.RemoveNode(ctx, d.Get("name").(string))
+	if err != nil && !errors.Is(err, lib.ErrClusterNotFound) {
+		return diagFromErr(err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+func resourceNodeImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	clusterName, name, err := parseNodeId(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := initialiseExistingClusterClient(m, clusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	// Hand the node over from the node count of the cluster to this resource
+	err = client.AttachNode(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("cluster_name", clusterName)
+	d.Set("name", name)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func nodeId(clusterName string, name string) string {
+	return fmt.Sprintf("%s/%s", clusterName, name)
+}
+
+func parseNodeId(id string) (string, string, error) {
+	clusterName, name, ok := strings.Cut(id, "/")
+	if !ok || clusterName == "" || name == "" {
+		return "", "", fmt.Errorf("unexpected node id %q, expected <cluster_name>/<node_name>", id)
+	}
+
+	return clusterName, name, nil
+}
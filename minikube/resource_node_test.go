@@ -0,0 +1,167 @@
+package minikube
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/scott-the-programmer/terraform-provider-minikube/minikube/lib"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"k8s.io/minikube/pkg/minikube/config"
+)
+
+func TestNodeCreation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  map[string]*schema.Provider{"minikube": NewProvider(mockNode(t, "TestNodeCreation"))},
+		Steps: []resource.TestStep{
+			{
+				Config: testUnitNodeConfig("TestNodeCreation"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("minikube_node.worker", "id", "TestNodeCreation/m02"),
+					resource.TestCheckResourceAttr("minikube_node.worker", "name", "m02"),
+					resource.TestCheckResourceAttr("minikube_node.worker", "ip", "192.168.49.3"),
+					resource.TestCheckResourceAttr("minikube_node.worker", "role", "worker"),
+					resource.TestCheckResourceAttr("minikube_node.worker", "container_runtime", "docker"),
+					resource.TestCheckResourceAttr("minikube_node.worker", "kubernetes_version", "v1.30.0"),
+				),
+			},
+		},
+	})
+}
+
+func TestNodeDeleteClusterDeleted(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockClusterClient := lib.NewMockClusterClient(ctrl)
+
+	mockClusterClient.EXPECT().
+		SetConfig(gomock.Any()).
+		AnyTimes()
+
+	mockClusterClient.EXPECT().
+		SetDependencies(gomock.Any()).
+		AnyTimes()
+
+	mockClusterClient.EXPECT().
+		RemoveNode(gomock.Any(), "m02").
+		Return(fmt.Errorf("%w: TestNodeDeleteClusterDeleted", lib.ErrClusterNotFound))
+
+	mockClusterClientFactory := func() (lib.ClusterClient, error) {
+		return mockClusterClient, nil
+	}
+
+	d := schema.TestResourceDataRaw(t, ResourceNode().Schema, map[string]interface{}{
+		"cluster_name": "TestNodeDeleteClusterDeleted",
+	})
+	d.Set("name", "m02")
+	d.SetId("TestNodeDeleteClusterDeleted/m02")
+
+	diags := resourceNodeDelete(context.Background(), d, mockClusterClientFactory)
+	if diags.HasError() {
+		t.Fatalf("resourceNodeDelete() returned errors: %v", diags)
+	}
+
+	if d.Id() != "" {
+		t.Errorf("resourceNodeDelete() id = %q, want it removed from state", d.Id())
+	}
+}
+
+func TestNodeImport(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockClusterClient := lib.NewMockClusterClient(ctrl)
+
+	mockClusterClient.EXPECT().
+		SetConfig(lib.MinikubeClientConfig{ClusterName: "TestNodeImport"}).
+		AnyTimes()
+
+	mockClusterClient.EXPECT().
+		SetDependencies(gomock.Any()).
+		AnyTimes()
+
+	mockClusterClient.EXPECT().
+		AttachNode(gomock.Any(), "m02").
+		Return(nil)
+
+	mockClusterClientFactory := func() (lib.ClusterClient, error) {
+		return mockClusterClient, nil
+	}
+
+	d := ResourceNode().Data(nil)
+	d.SetId("TestNodeImport/m02")
+
+	imported, err := resourceNodeImport(context.Background(), d, mockClusterClientFactory)
+	if err != nil {
+		t.Fatalf("resourceNodeImport() error = %v", err)
+	}
+
+	if got := imported[0].Get("cluster_name"); got != "TestNodeImport" {
+		t.Errorf("imported cluster_name = %v, want TestNodeImport", got)
+	}
+	if got := imported[0].Get("name"); got != "m02" {
+		t.Errorf("imported name = %v, want m02", got)
+	}
+}
+
+func mockNode(t *testing.T, clusterName string) schema.ConfigureContextFunc {
+	ctrl := gomock.NewController(t)
+	mockClusterClient := lib.NewMockClusterClient(ctrl)
+
+	worker := config.Node{
+		Name:              "m02",
+		IP:                "192.168.49.3",
+		Worker:            true,
+		KubernetesVersion: "v1.30.0",
+		ContainerRuntime:  "docker",
+	}
+
+	mockClusterClient.EXPECT().
+		SetConfig(lib.MinikubeClientConfig{ClusterName: clusterName}).
+		AnyTimes()
+
+	mockClusterClient.EXPECT().
+		SetDependencies(gomock.Any()).
+		AnyTimes()
+
+	mockClusterClient.EXPECT().
+		AddNode(gomock.Any(), lib.NodeOptions{Role: lib.RoleWorker}).
+		Return(&worker, nil).
+		Times(1)
+
+	mockClusterClient.EXPECT().
+		LoadClusterConfig().
+		Return(&config.ClusterConfig{
+			Name: clusterName,
+			Nodes: []config.Node{
+				{Name: "", IP: "192.168.49.2", ControlPlane: true, Worker: true, KubernetesVersion: "v1.30.0", ContainerRuntime: "docker"},
+				worker,
+			},
+		}, nil).
+		AnyTimes()
+
+	mockClusterClient.EXPECT().
+		RemoveNode(gomock.Any(), "m02").
+		Return(nil).
+		Times(1)
+
+	configureContext := func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+		var diags diag.Diagnostics
+		mockClusterClientFactory := func() (lib.ClusterClient, error) {
+			return mockClusterClient, nil
+		}
+		return mockClusterClientFactory, diags
+	}
+
+	return configureContext
+}
+
+func testUnitNodeConfig(clusterName string) string {
+	return fmt.Sprintf(`
+	resource "minikube_node" "worker" {
+		cluster_name = "%s"
+	}
+	`, clusterName)
+}
@@ -49,9 +49,139 @@ var (
 			Description: "the host name for the cluster",
 		},
 
+		"kubeconfig_raw": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Sensitive:   true,
+			Description: "A complete, self-contained kubeconfig for the cluster, with its certificates embedded",
+		},
+
+		"kubeconfig_path": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "The kubeconfig file the context of the cluster is written to instead of the global one, with 0600 permissions. Defaults to the provider's kubeconfig_path, then to KUBECONFIG or ~/.kube/config. Changing it restarts the cluster in place",
+		},
+
+		"kubeconfig_previous_context": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The current-context of the kubeconfig before the cluster was created. It is restored on destroy if the context of the cluster is still the current one",
+		},
+
+		"destroy_mode": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Default:          "delete",
+			Description:      "What destroying the resource does to the cluster, one of delete, purge or stop. delete removes the machine and profile, purge also removes the cached ISO, preload and kic base image the cluster was created from, and stop only stops the machine, leaving it on disk to be adopted again by a cluster of the same name",
+			ValidateDiagFunc: state_utils.DestroyModeValidator(),
+		},
+
+		"control_plane_nodes": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "The number of control plane nodes running in the cluster",
+		},
+
+		"node": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "The nodes of the cluster, starting with the primary control plane",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The name of the node's machine, e.g. minikube for the primary control plane of cluster minikube and minikube-m02 for its second node, the same as in status",
+					},
+					"ip": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The IP address of the node, as reported by the driver",
+					},
+					"role": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The role of the node, either control-plane or worker",
+					},
+					"container_runtime": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The container runtime of the node",
+					},
+					"kubernetes_version": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The Kubernetes version of the node",
+					},
+				},
+			},
+		},
+
+		"health": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The overall health of the cluster, one of healthy, degraded, paused or stopped. The cluster is only healthy when every component of every node is running",
+		},
+
+		"status": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "The status of each node, as reported by minikube status",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The name of the node's machine",
+					},
+					"host": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The state of the node's machine",
+					},
+					"kubelet": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The state of the node's kubelet",
+					},
+					"apiserver": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The state of the node's apiserver. Irrelevant for workers",
+					},
+					"kubeconfig": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Whether the kubeconfig points at the node. Irrelevant for workers",
+					},
+					"worker": {
+						Type:        schema.TypeBool,
+						Computed:    true,
+						Description: "Whether the node is a worker only, without a control plane",
+					},
+				},
+			},
+		},
+
+		"state": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Computed:         true,
+			Description:      "The desired state of the cluster, one of running, stopped or paused. Changing it stops, pauses or starts the cluster in place, keeping its volumes and images. nodes and addons cannot be changed while the cluster stays stopped. Defaults to the actual state of the cluster",
+			ValidateDiagFunc: state_utils.StateValidator(),
+		},
+
+		"ensure_running": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Start the cluster again on the next apply if it is found stopped, e.g. after the host rebooted. Has no effect when state is set",
+		},
+
 		"addons": {
 			Type:        schema.TypeSet,
-			Description: "Enable addons. see `minikube addons list` for a list of valid addon names.",
+			Description: "Enable addons. see `minikube addons list` for a list of valid addon names. Addons enabled outside of this list, e.g. by minikube_addon, are not tracked by the cluster.",
 
 			Optional: true,
 
@@ -62,10 +192,9 @@ var (
 
 		"apiserver_ips": {
 			Type:        schema.TypeSet,
-			Description: "A set of apiserver IP Addresses which are used in the generated certificate for kubernetes.  This can be used if you want to make the apiserver available from outside the machine",
+			Description: "A set of apiserver IP Addresses which are used in the generated certificate for kubernetes.  This can be used if you want to make the apiserver available from outside the machine. Changing it restarts the cluster in place and regenerates the apiserver certificate",
 
 			Optional: true,
-			ForceNew: true,
 
 			Elem: &schema.Schema{
 				Type: schema.TypeString,
@@ -84,12 +213,11 @@ var (
 
 		"apiserver_names": {
 			Type:        schema.TypeSet,
-			Description: "A set of apiserver names which are used in the generated certificate for kubernetes.  This can be used if you want to make the apiserver available from outside the machine",
+			Description: "A set of apiserver names which are used in the generated certificate for kubernetes.  This can be used if you want to make the apiserver available from outside the machine. Changing it restarts the cluster in place and regenerates the apiserver certificate",
 
 			Computed: true,
 
 			Optional: true,
-			ForceNew: true,
 
 			Elem: &schema.Schema{
 				Type: schema.TypeString,
@@ -188,10 +316,9 @@ var (
 
 		"cpus": {
 			Type:        schema.TypeString,
-			Description: "Number of CPUs allocated to Kubernetes. Use \"max\" to use the maximum number of CPUs. Use \"no-limit\" to not specify a limit (Docker/Podman only)",
+			Description: "Number of CPUs allocated to Kubernetes. Use \"max\" to use the maximum number of CPUs. Use \"no-limit\" to not specify a limit (Docker/Podman only). Changing it restarts the cluster in place for the docker, podman, kvm2 and qemu2 drivers, other drivers recreate the cluster",
 
 			Optional: true,
-			ForceNew: true,
 
 			Default:          "2",
 			StateFunc:        state_utils.CPUConverter(),
@@ -260,7 +387,7 @@ var (
 
 		"disk_size": {
 			Type:        schema.TypeString,
-			Description: "Disk size allocated to the minikube VM (format: <number>[<unit>(case-insensitive)], where unit = b, k, kb, m, mb, g or gb)",
+			Description: "Disk size allocated to the minikube VM (format: <number>[<unit>(case-insensitive)], where unit = b, k, kb, m, mb, g or gb). Changing it recreates the cluster, as machine disks are sized when they are created",
 
 			Optional: true,
 			ForceNew: true,
@@ -356,10 +483,9 @@ var (
 
 		"extra_config": {
 			Type:        schema.TypeSet,
-			Description: "A set of key=value pairs that describe configuration that may be passed to different components. 		The key should be '.' separated, and the first part before the dot is the component to apply the configuration to. 		Valid components are: kubelet, kubeadm, apiserver, controller-manager, etcd, proxy, scheduler 		Valid kubeadm parameters: ignore-preflight-errors, dry-run, kubeconfig, kubeconfig-dir, node-name, cri-socket, experimental-upload-certs, certificate-key, rootfs, skip-phases, pod-network-cidr",
+			Description: "A set of key=value pairs that describe configuration that may be passed to different components. 		The key should be '.' separated, and the first part before the dot is the component to apply the configuration to. 		Valid components are: kubelet, kubeadm, apiserver, controller-manager, etcd, proxy, scheduler 		Valid kubeadm parameters: ignore-preflight-errors, dry-run, kubeconfig, kubeconfig-dir, node-name, cri-socket, experimental-upload-certs, certificate-key, rootfs, skip-phases, pod-network-cidr. Changing it restarts the cluster in place, re-running kubeadm with the new configuration",
 
 			Optional: true,
-			ForceNew: true,
 
 			Elem: &schema.Schema{
 				Type: schema.TypeString,
@@ -378,10 +504,9 @@ var (
 
 		"feature_gates": {
 			Type:        schema.TypeString,
-			Description: "A set of key=value pairs that describe feature gates for alpha/experimental features.",
+			Description: "A set of key=value pairs that describe feature gates for alpha/experimental features. Changing it restarts the cluster in place, re-running kubeadm with the new configuration",
 
 			Optional: true,
-			ForceNew: true,
 
 			Default: "",
 		},
@@ -590,12 +715,11 @@ var (
 
 		"kubernetes_version": {
 			Type:        schema.TypeString,
-			Description: "The Kubernetes version that the minikube VM will use (ex: v1.2.3, 'stable' for v1.35.0, 'latest' for v1.35.0). Defaults to 'stable'.",
+			Description: "The Kubernetes version that the minikube VM will use (ex: v1.2.3). Defaults to the provider's kubernetes_version. Raising the version upgrades the cluster in place, downgrades are not supported.",
 
-			Optional: true,
-			ForceNew: true,
+			Computed: true,
 
-			Default: "",
+			Optional: true,
 		},
 
 		"kvm_gpu": {
@@ -660,10 +784,9 @@ var (
 
 		"memory": {
 			Type:        schema.TypeString,
-			Description: "Amount of RAM to allocate to Kubernetes (format: <number>[<unit>], where unit = b, k, m or g). Use \"max\" to use the maximum amount of memory. Use \"no-limit\" to not specify a limit (Docker/Podman only))",
+			Description: "Amount of RAM to allocate to Kubernetes (format: <number>[<unit>], where unit = b, k, m or g). Use \"max\" to use the maximum amount of memory. Use \"no-limit\" to not specify a limit (Docker/Podman only)). Changing it restarts the cluster in place for the docker, podman, kvm2 and qemu2 drivers, other drivers recreate the cluster",
 
 			Optional: true,
-			ForceNew: true,
 
 			Default:          "4g",
 			StateFunc:        state_utils.MemoryConverter(),
@@ -869,10 +992,9 @@ var (
 
 		"nodes": {
 			Type:        schema.TypeInt,
-			Description: "The total number of nodes to spin up. Defaults to 1.",
+			Description: "The total number of nodes to spin up. Defaults to 1. Changing it adds or removes worker nodes in place, control plane nodes are never removed. Nodes attached by minikube_node are not counted.",
 
 			Optional: true,
-			ForceNew: true,
 
 			Default: 1,
 		},
@@ -933,12 +1055,11 @@ var (
 
 		"registry_mirror": {
 			Type:        schema.TypeSet,
-			Description: "Registry mirrors to pass to the Docker daemon",
+			Description: "Registry mirrors to pass to the Docker daemon. Changing it restarts the cluster in place",
 
 			Computed: true,
 
 			Optional: true,
-			ForceNew: true,
 
 			Elem: &schema.Schema{
 				Type: schema.TypeString,
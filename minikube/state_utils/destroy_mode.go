@@ -0,0 +1,35 @@
+package state_utils
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/scott-the-programmer/terraform-provider-minikube/minikube/lib"
+)
+
+func DestroyModeValidator() schema.SchemaValidateDiagFunc {
+	return schema.SchemaValidateDiagFunc(func(val interface{}, path cty.Path) diag.Diagnostics {
+		err := DestroyModeValidatorImpl(val)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		return nil
+	})
+}
+
+func DestroyModeValidatorImpl(val interface{}) error {
+	modeStr, ok := val.(string)
+	if !ok {
+		return errors.New("destroy_mode value is not a string")
+	}
+
+	switch modeStr {
+	case lib.DestroyModeDelete, lib.DestroyModePurge, lib.DestroyModeStop:
+		return nil
+	}
+
+	return fmt.Errorf("invalid destroy_mode %q, expected one of %s, %s or %s", modeStr, lib.DestroyModeDelete, lib.DestroyModePurge, lib.DestroyModeStop)
+}
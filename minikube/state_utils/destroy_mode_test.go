@@ -0,0 +1,22 @@
+package state_utils
+
+import (
+	"testing"
+
+	"github.com/scott-the-programmer/terraform-provider-minikube/minikube/lib"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDestroyModeValidator(t *testing.T) {
+	validator := DestroyModeValidator()
+
+	// Test valid cases
+	assert.Nil(t, validator(lib.DestroyModeDelete, nil))
+	assert.Nil(t, validator(lib.DestroyModePurge, nil))
+	assert.Nil(t, validator(lib.DestroyModeStop, nil))
+
+	// Test invalid cases
+	assert.NotNil(t, validator(123, nil))
+	assert.NotNil(t, validator("pause", nil))
+	assert.NotNil(t, validator("", nil))
+}
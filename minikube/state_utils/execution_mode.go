@@ -0,0 +1,35 @@
+package state_utils
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/scott-the-programmer/terraform-provider-minikube/minikube/lib"
+)
+
+func ExecutionModeValidator() schema.SchemaValidateDiagFunc {
+	return schema.SchemaValidateDiagFunc(func(val interface{}, path cty.Path) diag.Diagnostics {
+		err := ExecutionModeValidatorImpl(val)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		return nil
+	})
+}
+
+func ExecutionModeValidatorImpl(val interface{}) error {
+	modeStr, ok := val.(string)
+	if !ok {
+		return errors.New("execution_mode value is not a string")
+	}
+
+	switch modeStr {
+	case lib.ExecutionModeInProcess, lib.ExecutionModeSubprocess:
+		return nil
+	}
+
+	return fmt.Errorf("invalid execution_mode %q, expected one of %s or %s", modeStr, lib.ExecutionModeInProcess, lib.ExecutionModeSubprocess)
+}
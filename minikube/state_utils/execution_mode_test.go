@@ -0,0 +1,21 @@
+package state_utils
+
+import (
+	"testing"
+
+	"github.com/scott-the-programmer/terraform-provider-minikube/minikube/lib"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExecutionModeValidator(t *testing.T) {
+	validator := ExecutionModeValidator()
+
+	// Test valid cases
+	assert.Nil(t, validator(lib.ExecutionModeInProcess, nil))
+	assert.Nil(t, validator(lib.ExecutionModeSubprocess, nil))
+
+	// Test invalid cases
+	assert.NotNil(t, validator(123, nil))
+	assert.NotNil(t, validator("thread", nil))
+	assert.NotNil(t, validator("", nil))
+}
@@ -50,3 +50,22 @@ func SetToSlice(s *schema.Set) []string {
 
 	return ss
 }
+
+// Intersection returns the sorted elements of a that are also present in b
+func Intersection(a []string, b []string) []string {
+	lookup := make(map[string]struct{}, len(b))
+	for _, v := range b {
+		lookup[v] = struct{}{}
+	}
+
+	intersection := make([]string, 0)
+	for _, v := range a {
+		if _, found := lookup[v]; found {
+			intersection = append(intersection, v)
+		}
+	}
+
+	sort.Strings(intersection) //to ensure consistency with TF state
+
+	return intersection
+}
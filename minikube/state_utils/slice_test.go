@@ -124,3 +124,39 @@ func TestSetToSlice(t *testing.T) {
 		})
 	}
 }
+
+func TestIntersection(t *testing.T) {
+	type args struct {
+		a []string
+		b []string
+	}
+	tests := []struct {
+		name string
+		args args
+		want []string
+	}{
+		{
+			name: "Should return shared elements in order",
+			args: args{
+				a: []string{"ingress", "dashboard", "metallb"},
+				b: []string{"metallb", "dashboard"},
+			},
+			want: []string{"dashboard", "metallb"},
+		},
+		{
+			name: "Should return empty slice when nothing is shared",
+			args: args{
+				a: []string{"ingress"},
+				b: []string{},
+			},
+			want: []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Intersection(tt.args.a, tt.args.b); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Intersection() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package state_utils
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/scott-the-programmer/terraform-provider-minikube/minikube/lib"
+)
+
+func StateValidator() schema.SchemaValidateDiagFunc {
+	return schema.SchemaValidateDiagFunc(func(val interface{}, path cty.Path) diag.Diagnostics {
+		err := StateValidatorImpl(val)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		return nil
+	})
+}
+
+func StateValidatorImpl(val interface{}) error {
+	stateStr, ok := val.(string)
+	if !ok {
+		return errors.New("state value is not a string")
+	}
+
+	switch stateStr {
+	case lib.StateRunning, lib.StateStopped, lib.StatePaused:
+		return nil
+	}
+
+	return fmt.Errorf("invalid state %q, expected one of %s, %s or %s", stateStr, lib.StateRunning, lib.StateStopped, lib.StatePaused)
+}
@@ -0,0 +1,22 @@
+package state_utils
+
+import (
+	"testing"
+
+	"github.com/scott-the-programmer/terraform-provider-minikube/minikube/lib"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStateValidator(t *testing.T) {
+	validator := StateValidator()
+
+	// Test valid cases
+	assert.Nil(t, validator(lib.StateRunning, nil))
+	assert.Nil(t, validator(lib.StateStopped, nil))
+	assert.Nil(t, validator(lib.StatePaused, nil))
+
+	// Test invalid cases
+	assert.NotNil(t, validator(123, nil))
+	assert.NotNil(t, validator(lib.StateInvalid, nil))
+	assert.NotNil(t, validator("deleted", nil))
+}